@@ -2,36 +2,95 @@ package instantly
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"net/mail"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/ratelimit"
 )
 
 var (
-	ErrMarshalFailed          = errors.New("failed to unmarshal object")
+	ErrMarshalFailed          = errors.New("failed to marshal object")
 	ErrUnmarshalFailed        = errors.New("failed to unmarshal object")
 	ErrRequestCreationFailed  = errors.New("failed to create request")
 	ErrRequestExecutionFailed = errors.New("failed to execute request")
-	ErrRequestBodyReadFailed  = errors.New("failed to to read request body")
+	ErrRequestBodyReadFailed  = errors.New("failed to read response body")
+	ErrRateLimited            = errors.New("rate limit exceeded")
+	ErrInvalidResponse        = errors.New("response rejected by validator")
+	ErrAccountVitalsFailed    = errors.New("account vitals check failed")
+	ErrUnsuccessfulStatus     = errors.New("return status not successful")
+	ErrUnauthorized           = errors.New("unauthorized")
+	ErrApiError               = errors.New("api error")
+	ErrLeadNotFound           = errors.New("no lead found")
+	ErrCampaignNotFound       = errors.New("no campaign found")
+	ErrApiKeyUnavailable      = errors.New("failed to get api key")
+	ErrMissingApiKey          = errors.New("missing api key")
+	ErrOverlappingSchedules   = errors.New("campaign schedules overlap")
+	ErrCircuitOpen            = errors.New("circuit breaker open")
 )
 
+// DefaultPageSize is how many records paging helpers such as
+// ListAllCampaigns request at a time, unless set with WithPageSize.
+const DefaultPageSize = 100
+
 type Option func(option *options) error
 
 type options struct {
-	host       string
-	apiVersion int
-	rateLimit  *ratelimit.Limiter
-	httpClient *http.Client
-}
-
+	host             string
+	apiVersion       int
+	apiVersionString string
+	pathPrefix       string
+	rateLimit        *ratelimit.Limiter
+	httpClient       *http.Client
+	tlsConfig        *tls.Config
+	insecure         bool
+	maxConnsPerHost  int
+	idleConnTimeout  time.Duration
+	maxRetries       int
+	retryPolicy      func(res *http.Response, body []byte, err error) bool
+	pageSize         int
+	cooldown         time.Duration
+	logger           *log.Logger
+
+	circuitThreshold int
+	circuitCooldown  time.Duration
+
+	skipMalformed     bool
+	responseValidator func(path string, status int, body []byte) error
+	defaultParams     []query
+	debug             io.Writer
+	apiKeyProvider    func(ctx context.Context) (string, error)
+	cache             Cache
+}
+
+// WithHost sets the API host. A scheme or trailing slash, as in
+// "https://api.instantly.ai/", is stripped; requests always use https.
 func WithHost(host string) Option {
 	return func(option *options) error {
+		for _, scheme := range []string{"https://", "http://"} {
+			if len(host) >= len(scheme) && strings.EqualFold(host[:len(scheme)], scheme) {
+				host = host[len(scheme):]
+				break
+			}
+		}
+		host = strings.TrimRight(host, "/")
+		if host == "" {
+			return fmt.Errorf("invalid host: empty")
+		}
+
 		// Check if host is valid.
 		_, err := http.NewRequest("GET", fmt.Sprintf("https://%s", host), nil)
 		if err != nil {
@@ -54,6 +113,59 @@ func WithApiVersion(version int) Option {
 	}
 }
 
+// WithApiVersionString sets the version segment of the URL verbatim, for
+// versions that are not plain numbers: "v2beta" gives api/v2beta/.
+func WithApiVersionString(version string) Option {
+	return func(option *options) error {
+		version = strings.Trim(version, "/")
+		if version == "" || strings.Contains(version, "/") {
+			return fmt.Errorf("invalid api version: %q", version)
+		}
+
+		option.apiVersionString = version
+		return nil
+	}
+}
+
+// WithPathPrefix replaces the api/v<version> segment of every URL, for
+// proxies that mount the API elsewhere.
+func WithPathPrefix(prefix string) Option {
+	return func(option *options) error {
+		prefix = strings.Trim(prefix, "/")
+		if prefix == "" {
+			return fmt.Errorf("invalid path prefix")
+		}
+
+		option.pathPrefix = prefix
+		return nil
+	}
+}
+
+// WithDefaultParams adds query parameters to every GET request, unless the
+// method sets a parameter of the same name itself.
+func WithDefaultParams(params map[string]string) Option {
+	return func(option *options) error {
+		keys := make([]string, 0, len(params))
+		for key := range params {
+			if key == "" || key == "api_key" {
+				return fmt.Errorf("invalid default param: %q", key)
+			}
+
+			keys = append(keys, key)
+		}
+
+		// Sort so URLs are stable across requests.
+		sort.Strings(keys)
+
+		option.defaultParams = make([]query, len(keys))
+		for i, key := range keys {
+			option.defaultParams[i] = param(key, params[key])
+		}
+
+		return nil
+	}
+}
+
 func WithRateLimit(rl ratelimit.Limiter) Option {
 	return func(option *options) error {
 		option.rateLimit = &rl
@@ -68,13 +180,271 @@ func WithHttpClient(hc http.Client) Option {
 	}
 }
 
+func WithLogger(logger *log.Logger) Option {
+	return func(option *options) error {
+		if logger == nil {
+			return fmt.Errorf("invalid logger")
+		}
+
+		option.logger = logger
+		return nil
+	}
+}
+
+// WithSkipMalformed makes list methods drop records that fail to decode
+// instead of failing the whole call. The decode errors are still returned by
+// the list methods; methods built on them use the records that decoded.
+func WithSkipMalformed() Option {
+	return func(option *options) error {
+		option.skipMalformed = true
+		return nil
+	}
+}
+
+// WithResponseValidator registers a check run on every response body before
+// it is decoded. Returning an error aborts the method with that error.
+func WithResponseValidator(validator func(path string, status int, body []byte) error) Option {
+	return func(option *options) error {
+		if validator == nil {
+			return fmt.Errorf("invalid response validator")
+		}
+
+		option.responseValidator = validator
+		return nil
+	}
+}
+
+// WithDebug writes every request and raw response body to w, with the API
+// key masked. It is meant for troubleshooting, not production logging.
+func WithDebug(w io.Writer) Option {
+	return func(option *options) error {
+		if w == nil {
+			return fmt.Errorf("invalid debug writer")
+		}
+
+		option.debug = w
+		return nil
+	}
+}
+
+func WithPageSize(size int) Option {
+	return func(option *options) error {
+		if size < 1 {
+			return fmt.Errorf("invalid page size")
+		}
+
+		option.pageSize = size
+		return nil
+	}
+}
+
+func WithMaxRetries(retries int) Option {
+	return func(option *options) error {
+		if retries < 0 {
+			return fmt.Errorf("invalid max retries")
+		}
+
+		option.maxRetries = retries
+		return nil
+	}
+}
+
+// WithRetryPolicy replaces the check that decides whether a failed attempt is
+// retried, by default a network error or a 5xx status. policy gets either the
+// response with its body read, or the transport error with a nil response.
+// It is consulted only for idempotent requests (see IsIdempotent); a 429 is
+// always retried, and WithMaxRetries still caps the attempts.
+func WithRetryPolicy(policy func(res *http.Response, body []byte, err error) bool) Option {
+	return func(option *options) error {
+		if policy == nil {
+			return fmt.Errorf("invalid retry policy")
+		}
+
+		option.retryPolicy = policy
+		return nil
+	}
+}
+
+// DefaultRetryPolicy retries network errors and 5xx responses.
+func DefaultRetryPolicy(res *http.Response, body []byte, err error) bool {
+	return err != nil || res.StatusCode >= 500
+}
+
+// WithCooldown sets how long the client keeps itself throttled to one
+// request per second after the API answers with 429 Too Many Requests.
+func WithCooldown(cooldown time.Duration) Option {
+	return func(option *options) error {
+		if cooldown < 0 {
+			return fmt.Errorf("invalid cooldown")
+		}
+
+		option.cooldown = cooldown
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the default transport, such as
+// extra root CAs for a corporate proxy. It cannot be combined with
+// WithHttpClient; configure that client's transport instead.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(option *options) error {
+		if config == nil {
+			return fmt.Errorf("invalid tls config")
+		}
+
+		option.tlsConfig = config
+		return nil
+	}
+}
+
+// WithMaxConnsPerHost caps the connections the default transport opens to the
+// API, counting those in use and idle ones. It cannot be combined with
+// WithHttpClient.
+func WithMaxConnsPerHost(n int) Option {
+	return func(option *options) error {
+		if n < 1 {
+			return fmt.Errorf("invalid max conns per host")
+		}
+
+		option.maxConnsPerHost = n
+		return nil
+	}
+}
+
+// WithIdleConnTimeout sets how long the default transport keeps an idle
+// connection open for reuse. It cannot be combined with WithHttpClient.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(option *options) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid idle conn timeout")
+		}
+
+		option.idleConnTimeout = timeout
+		return nil
+	}
+}
+
+// WithAPIKeyProvider makes the client ask provider for the API key before
+// every request, for keys that are rotated while the client is in use. The
+// key passed to New is then ignored.
+func WithAPIKeyProvider(provider func(ctx context.Context) (string, error)) Option {
+	return func(option *options) error {
+		if provider == nil {
+			return fmt.Errorf("invalid api key provider")
+		}
+
+		option.apiKeyProvider = provider
+		return nil
+	}
+}
+
+// Cache stores GET response bodies by URL, with the API key masked, along
+// with their ETag. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (etag string, body []byte, ok bool)
+	Set(key, etag string, body []byte)
+}
+
+// WithCache makes GET requests conditional on the ETag of the cached
+// response, which is returned instead when the server answers 304 Not
+// Modified.
+func WithCache(cache Cache) Option {
+	return func(option *options) error {
+		if cache == nil {
+			return fmt.Errorf("invalid cache")
+		}
+
+		option.cache = cache
+		return nil
+	}
+}
+
+type memoryCacheEntry struct {
+	etag string
+	body []byte
+}
+
+// MemoryCache is an unbounded in-memory Cache.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+func (m *MemoryCache) Get(key string) (etag string, body []byte, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	return entry.etag, entry.body, ok
+}
+
+func (m *MemoryCache) Set(key, etag string, body []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = memoryCacheEntry{etag: etag, body: body}
+}
+
+// WithInsecureSkipVerify disables TLS certificate verification on the default
+// transport. It is for tests against a local mock server with a self-signed
+// certificate only: it lets anyone on the network read the API key.
+func WithInsecureSkipVerify() Option {
+	return func(option *options) error {
+		option.insecure = true
+		return nil
+	}
+}
+
+// WithCircuitBreaker makes the client fail fast with ErrCircuitOpen for
+// cooldown once threshold requests in a row have failed with a network error,
+// a 5xx status or an exhausted rate limit. After the cooldown requests are
+// let through again, and the first to fail opens the circuit again.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(option *options) error {
+		if threshold < 1 {
+			return fmt.Errorf("invalid circuit breaker threshold")
+		}
+		if cooldown <= 0 {
+			return fmt.Errorf("invalid circuit breaker cooldown")
+		}
+
+		option.circuitThreshold = threshold
+		option.circuitCooldown = cooldown
+		return nil
+	}
+}
+
+var insecureWarning sync.Once
+
+// A Client is safe for concurrent use by multiple goroutines. Options are
+// read-only once New returns; any state mutated while serving requests is
+// guarded by mu.
 type Client struct {
 	apiKey  string
 	options *options
+
+	cooldownLimit ratelimit.Limiter
+	mu            sync.Mutex
+	cooldownUntil time.Time
+
+	failures  int
+	openUntil time.Time
+
+	lastResponse ResponseMeta
+
+	// debugMu keeps dumps of concurrent requests from interleaving.
+	debugMu sync.Mutex
 }
 
 func New(apiKey string, opts ...Option) (*Client, error) {
-	o := &options{}
+	o := &options{
+		maxRetries: 3,
+		cooldown:   30 * time.Second,
+	}
 	for _, opt := range opts {
 		err := opt(o)
 		if err != nil {
@@ -82,24 +452,111 @@ func New(apiKey string, opts ...Option) (*Client, error) {
 		}
 	}
 
+	if apiKey == "" && o.apiKeyProvider == nil {
+		return nil, ErrMissingApiKey
+	}
+
 	// Set default values.
 	if o.host == "" {
 		o.host = "api.instantly.ai"
 	}
+	if o.apiVersion != 0 && o.apiVersionString != "" {
+		return nil, fmt.Errorf("bad option: api version set both as int and as string")
+	}
 	if o.apiVersion == 0 {
 		o.apiVersion = 1
 	}
+	if o.pathPrefix == "" && o.apiVersionString != "" {
+		o.pathPrefix = "api/" + o.apiVersionString
+	}
+	if o.pathPrefix == "" {
+		o.pathPrefix = fmt.Sprintf("api/v%d", o.apiVersion)
+	}
 	if o.rateLimit == nil {
 		// Our platform allows a maximum of 10 requests per second to prevent abuse.
 		// https://developer.instantly.ai/introduction/rate_limits
 		o.rateLimit = new(ratelimit.Limiter)
 		*o.rateLimit = ratelimit.New(10, ratelimit.Per(time.Second))
 	}
+	if o.httpClient != nil && (o.tlsConfig != nil || o.insecure) {
+		return nil, fmt.Errorf("bad option: tls config cannot be applied to a custom http client")
+	}
+	if o.httpClient != nil && (o.maxConnsPerHost != 0 || o.idleConnTimeout != 0) {
+		return nil, fmt.Errorf("bad option: connection settings cannot be applied to a custom http client")
+	}
+	if o.insecure {
+		if o.tlsConfig == nil {
+			o.tlsConfig = &tls.Config{}
+		} else {
+			o.tlsConfig = o.tlsConfig.Clone()
+		}
+		o.tlsConfig.InsecureSkipVerify = true
+	}
 	if o.httpClient == nil {
-		o.httpClient = http.DefaultClient
+		// Every request goes to the same host, so keep as many idle
+		// connections to it as the transport keeps overall, rather than
+		// the default of two, which cannot keep up with concurrent callers.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.ForceAttemptHTTP2 = true
+		transport.MaxIdleConnsPerHost = transport.MaxIdleConns
+		if o.maxConnsPerHost != 0 {
+			transport.MaxConnsPerHost = o.maxConnsPerHost
+			transport.MaxIdleConnsPerHost = o.maxConnsPerHost
+		}
+		if o.idleConnTimeout != 0 {
+			transport.IdleConnTimeout = o.idleConnTimeout
+		}
+		if o.tlsConfig != nil {
+			transport.TLSClientConfig = o.tlsConfig
+		}
+		o.httpClient = &http.Client{Transport: transport}
+	}
+	if o.pageSize == 0 {
+		o.pageSize = DefaultPageSize
+	}
+	if o.retryPolicy == nil {
+		o.retryPolicy = DefaultRetryPolicy
+	}
+	if o.logger == nil {
+		o.logger = log.Default()
 	}
 
-	return &Client{apiKey: apiKey, options: o}, nil
+	if o.insecure {
+		insecureWarning.Do(func() {
+			o.logger.Print("instantly: TLS certificate verification is disabled; use WithInsecureSkipVerify for testing only")
+		})
+	}
+
+	c := &Client{
+		apiKey:        apiKey,
+		options:       o,
+		cooldownLimit: ratelimit.New(1, ratelimit.Per(time.Second)),
+	}
+
+	// Rate limit in the transport so that every request sent through the
+	// client is limited, whichever code path sends it. The caller's client
+	// is copied rather than modified.
+	hc := *o.httpClient
+	hc.Transport = &rateLimitedTransport{base: hc.Transport, client: c}
+	o.httpClient = &hc
+
+	return c, nil
+}
+
+type rateLimitedTransport struct {
+	base   http.RoundTripper
+	client *Client
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.client.wait()
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
 }
 
 type query struct {
@@ -114,92 +571,568 @@ func param(key, value string) query {
 	}
 }
 
+var timestampLayouts = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// parseTimestamp tries every layout the API is known to use. A timestamp in
+// none of them is logged and left as the zero time, so one odd record does
+// not fail the whole response.
+func parseTimestamp(logger *log.Logger, field, value string) time.Time {
+	for _, layout := range timestampLayouts {
+		timestamp, err := time.Parse(layout, value)
+		if err == nil {
+			return timestamp
+		}
+	}
+
+	logger.Printf("instantly: unrecognized %s %q, using zero time", field, value)
+	return time.Time{}
+}
+
 func (c *Client) buildUrl(path string) string {
-	return fmt.Sprintf("https://%s/api/v%d/%s", c.options.host, c.options.apiVersion, path)
+	return fmt.Sprintf("https://%s/%s/%s", c.options.host, c.options.pathPrefix, path)
 }
 
-func (c *Client) buildQueryUrl(path string, params []query) string {
-	url := c.buildUrl(path)
-	url = fmt.Sprintf("%s?api_key=%s", url, c.apiKey)
+func (c *Client) buildQueryUrl(apiKey, path string, params []query) string {
+	endpoint := c.buildUrl(path)
+	endpoint = fmt.Sprintf("%s?api_key=%s", endpoint, url.QueryEscape(apiKey))
 	for _, param := range params {
-		url = fmt.Sprintf("%s&%s=%s", url, param.key, param.value)
+		endpoint = fmt.Sprintf("%s&%s=%s", endpoint, url.QueryEscape(param.key), url.QueryEscape(param.value))
 	}
 
-	return url
-}
+	// Parameters passed by the method take precedence over defaults.
+	for _, def := range c.options.defaultParams {
+		overridden := false
+		for _, param := range params {
+			if param.key == def.key {
+				overridden = true
+				break
+			}
+		}
 
-func (c *Client) get(path string, params []query) (data []byte, err error) {
-	url := c.buildQueryUrl(path, params)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, ErrRequestCreationFailed
+		if !overridden {
+			endpoint = fmt.Sprintf("%s&%s=%s", endpoint, url.QueryEscape(def.key), url.QueryEscape(def.value))
+		}
 	}
 
-	// Wait for rate limit.
-	(*c.options.rateLimit).Take()
-	res, err := c.options.httpClient.Do(req)
+	return endpoint
+}
+
+func (c *Client) get(ctx context.Context, path string, params []query) (data []byte, err error) {
+	data, _, err = c.getWithStatus(ctx, path, params)
+	return data, err
+}
+
+// getWithStatus is get for the few callers that need the HTTP status as well
+// as the body.
+func (c *Client) getWithStatus(ctx context.Context, path string, params []query) (data []byte, status int, err error) {
+	apiKey, err := c.key(ctx)
 	if err != nil {
-		return nil, ErrRequestExecutionFailed
+		return nil, 0, err
 	}
-	defer res.Body.Close()
 
-	data, err = io.ReadAll(res.Body)
+	endpoint := c.buildQueryUrl(apiKey, path, params)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, ErrRequestBodyReadFailed
+		redactUrlError(err, apiKey)
+		return nil, 0, fmt.Errorf("%w: %w", ErrRequestCreationFailed, err)
 	}
 
-	return data, nil
+	return c.do(path, apiKey, req)
 }
 
-func (c *Client) post(path string, body any) (data []byte, err error) {
+func (c *Client) post(ctx context.Context, path string, body any) (data []byte, err error) {
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		return nil, ErrMarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrMarshalFailed, err)
 	}
 
+	// Decode numbers as json.Number so large integers, such as 64-bit custom
+	// variables, are re-encoded exactly instead of going through float64.
 	var bodyMap map[string]interface{}
-	err = json.Unmarshal(jsonBody, &bodyMap)
+	decoder := json.NewDecoder(bytes.NewReader(jsonBody))
+	decoder.UseNumber()
+	err = decoder.Decode(&bodyMap)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	apiKey, err := c.key(ctx)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, err
 	}
 
-	bodyMap["api_key"] = c.apiKey
+	bodyMap["api_key"] = apiKey
 
 	jsonBody, err = json.Marshal(bodyMap)
 	if err != nil {
-		return nil, ErrMarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrMarshalFailed, err)
 	}
 
-	url := c.buildUrl(path)
-	req, err := http.NewRequest("POST", url, bytes.NewReader(jsonBody))
+	endpoint := c.buildUrl(path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
 	if err != nil {
-		return nil, ErrRequestCreationFailed
+		return nil, fmt.Errorf("%w: %w", ErrRequestCreationFailed, err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	// Wait for rate limit.
-	(*c.options.rateLimit).Take()
-	res, err := c.options.httpClient.Do(req)
+	data, _, err = c.do(path, apiKey, req)
+	return data, err
+}
+
+// key returns the API key to send with a request, asking the provider for
+// the current one if the client has one.
+func (c *Client) key(ctx context.Context) (string, error) {
+	if c.options.apiKeyProvider == nil {
+		return c.apiKey, nil
+	}
+
+	apiKey, err := c.options.apiKeyProvider(ctx)
 	if err != nil {
-		return nil, ErrRequestExecutionFailed
+		return "", fmt.Errorf("%w: %w", ErrApiKeyUnavailable, err)
+	}
+
+	return apiKey, nil
+}
+
+func (c *Client) do(path, apiKey string, req *http.Request) (data []byte, status int, err error) {
+	retryable := isIdempotent(req.Context(), req.Method, path)
+
+	if c.options.circuitThreshold > 0 {
+		err = c.allowRequest()
+		if err != nil {
+			return nil, status, err
+		}
+
+		defer func() {
+			// A cancelled request says nothing about the API's health.
+			if req.Context().Err() != nil {
+				return
+			}
+
+			failed := errors.Is(err, ErrRequestExecutionFailed) || errors.Is(err, ErrRateLimited) || status >= 500
+			c.recordResult(failed)
+		}()
+	}
+
+	var cacheKey string
+	var cachedBody []byte
+	if c.options.cache != nil && req.Method == http.MethodGet {
+		cacheKey = redactUrl(req.URL.String())
+		etag, body, ok := c.options.cache.Get(cacheKey)
+		if ok {
+			req.Header.Set("If-None-Match", etag)
+			cachedBody = body
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		res, err := c.options.httpClient.Do(req)
+		if err != nil {
+			// Transport errors quote the URL, which carries the API key.
+			redactUrlError(err, apiKey)
+
+			err = fmt.Errorf("%w: %w", ErrRequestExecutionFailed, err)
+			if !retryable || attempt >= c.options.maxRetries || req.Context().Err() != nil || !c.options.retryPolicy(nil, nil, err) {
+				return nil, status, err
+			}
+		} else {
+			status = res.StatusCode
+			data, err = io.ReadAll(res.Body)
+			res.Body.Close()
+			c.setLastResponse(ResponseMeta{
+				StatusCode: res.StatusCode,
+				Header:     res.Header.Clone(),
+				Duration:   time.Since(start),
+			})
+			if err != nil {
+				return nil, status, fmt.Errorf("%w: %w", ErrRequestBodyReadFailed, err)
+			}
+
+			if c.options.debug != nil {
+				c.dump(req, res, data, apiKey)
+			}
+
+			switch {
+			case res.StatusCode == http.StatusTooManyRequests:
+				// A 429 means the request was not processed, so it is retried
+				// whether or not it is idempotent.
+				if attempt >= c.options.maxRetries {
+					return nil, status, ErrRateLimited
+				}
+
+				// The limiter let this request through, so our budget is out
+				// of sync with the server's. Slow every request down, not just
+				// this one.
+				c.coolDown()
+			case retryable && attempt < c.options.maxRetries && c.options.retryPolicy(res, data, nil):
+			default:
+				if cacheKey != "" {
+					etag := res.Header.Get("ETag")
+					switch {
+					case res.StatusCode == http.StatusNotModified && cachedBody != nil:
+						// Callers see the cached response as the 200 it was.
+						data = cachedBody
+						status = http.StatusOK
+					case res.StatusCode == http.StatusOK && etag != "":
+						c.options.cache.Set(cacheKey, etag, data)
+					}
+				}
+
+				if c.options.responseValidator != nil {
+					err = c.options.responseValidator(path, status, data)
+					if err != nil {
+						return nil, status, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+					}
+				}
+
+				return data, status, nil
+			}
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, status, req.Context().Err()
+		case <-time.After(retryAfter(res, attempt)):
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, status, fmt.Errorf("%w: %w", ErrRequestCreationFailed, err)
+			}
+		}
+	}
+}
+
+// ResponseMeta describes an HTTP response received by the client.
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+	// Duration runs from sending the request to reading the whole body.
+	Duration time.Duration
+}
+
+// LastResponse returns the most recent response received by any request,
+// including retried attempts. It is the zero ResponseMeta before the first
+// response.
+func (c *Client) LastResponse() ResponseMeta {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastResponse
+}
+
+func (c *Client) setLastResponse(meta ResponseMeta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastResponse = meta
+}
+
+// dump writes the exchange to the debug writer with the API key masked.
+func (c *Client) dump(req *http.Request, res *http.Response, resBody []byte, apiKey string) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			reqBody, _ = io.ReadAll(body)
+		}
 	}
-	defer res.Body.Close()
 
-	data, err = io.ReadAll(res.Body)
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
+
+	fmt.Fprintf(c.options.debug, "> %s %s\n", req.Method, redactUrl(req.URL.String()))
+	if len(reqBody) > 0 {
+		fmt.Fprintf(c.options.debug, "> %s\n", redact(string(reqBody), apiKey))
+	}
+	fmt.Fprintf(c.options.debug, "< %s\n", res.Status)
+	fmt.Fprintf(c.options.debug, "< %s\n\n", redact(string(resBody), apiKey))
+}
+
+// redactUrl masks the api_key query parameter so the URL is safe to log.
+func redactUrl(raw string) string {
+	u, err := url.Parse(raw)
 	if err != nil {
-		return nil, ErrRequestBodyReadFailed
+		return raw
+	}
+
+	query := u.Query()
+	if !query.Has("api_key") {
+		return raw
+	}
+
+	query.Set("api_key", "REDACTED")
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// redactUrlError masks the API key in the URL quoted by a *url.Error. The URL
+// may be one that does not parse, so the escaped key is also replaced as is.
+func redactUrlError(err error, apiKey string) {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redact(redactUrl(urlErr.URL), url.QueryEscape(apiKey))
+	}
+}
+
+func redact(s, apiKey string) string {
+	if apiKey == "" {
+		return s
+	}
+
+	return strings.ReplaceAll(s, apiKey, "REDACTED")
+}
+
+func (c *Client) wait() {
+	(*c.options.rateLimit).Take()
+
+	c.mu.Lock()
+	cooling := time.Now().Before(c.cooldownUntil)
+	c.mu.Unlock()
+
+	if cooling {
+		c.cooldownLimit.Take()
+	}
+}
+
+func (c *Client) coolDown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cooldownUntil = time.Now().Add(c.options.cooldown)
+}
+
+func (c *Client) allowRequest() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.openUntil) {
+		return ErrCircuitOpen
+	}
+
+	return nil
+}
+
+// recordResult opens the circuit once the threshold of consecutive failures
+// is reached. The count is kept until a success, so after the cooldown the
+// next failure opens it again straight away.
+func (c *Client) recordResult(failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !failed {
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.failures >= c.options.circuitThreshold {
+		c.openUntil = time.Now().Add(c.options.circuitCooldown)
+	}
+}
+
+// maxBackoff caps the wait between retries when the server does not say how
+// long to wait.
+const maxBackoff = 30 * time.Second
+
+func retryAfter(res *http.Response, attempt int) time.Duration {
+	if res != nil {
+		header := res.Header.Get("Retry-After")
+		seconds, err := strconv.Atoi(header)
+		if err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+
+		// Retry-After may also be an HTTP date.
+		date, err := http.ParseTime(header)
+		if err == nil {
+			wait := time.Until(date)
+			if wait > 0 {
+				return wait
+			}
+		}
+	}
+
+	// 1s, 2s, 4s, 8s and 16s, then the cap. Stopping the shift early also
+	// keeps it from overflowing on late attempts.
+	if attempt < 5 {
+		return time.Second << attempt
 	}
 
-	return data, nil
+	return maxBackoff
+}
+
+// Endpoints that create something on every call, so a request that failed
+// after reaching the server may already have taken effect.
+var nonIdempotentPaths = map[string]bool{
+	"lead/add":            true,
+	"unibox/emails/reply": true,
 }
 
-func (c *Client) Authenticate() (workspaceName string, err error) {
-	data, err := c.get("authenticate", nil)
+// IsIdempotent reports whether a request to the endpoint at path can be
+// retried after a server or network error without risking a duplicate
+// effect. GET requests always can; POST requests can unless they create
+// something. Requests rejected with 429 are retried regardless.
+func IsIdempotent(method, path string) bool {
+	return method == http.MethodGet || !nonIdempotentPaths[path]
+}
+
+type idempotencyKey struct{}
+
+// WithIdempotency overrides IsIdempotent for requests made with the returned
+// context, to opt a call into retries or out of them.
+func WithIdempotency(ctx context.Context, idempotent bool) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, idempotent)
+}
+
+func isIdempotent(ctx context.Context, method, path string) bool {
+	idempotent, ok := ctx.Value(idempotencyKey{}).(bool)
+	if ok {
+		return idempotent
+	}
+
+	return IsIdempotent(method, path)
+}
+
+type authenticateResponse struct {
+	WorkspaceName string `json:"workspace_name"`
+}
+
+// parseStatus maps the status field most responses carry to an error.
+func parseStatus(status string) error {
+	switch status {
+	case "success":
+		return nil
+	case "unauthorized":
+		return fmt.Errorf("%w: %w", ErrUnsuccessfulStatus, ErrUnauthorized)
+	case "error":
+		return fmt.Errorf("%w: %w", ErrUnsuccessfulStatus, ErrApiError)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsuccessfulStatus, status)
+	}
+}
+
+func (c *Client) Authenticate(ctx context.Context) (workspaceName string, err error) {
+	data, err := c.get(ctx, "authenticate", nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to authenticate: %w", err)
 	}
 
-	return string(data), nil
+	res := &authenticateResponse{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	return res.WorkspaceName, nil
+}
+
+// IsAuthenticated reports whether the API key is accepted, that is whether
+// the API answers 200 with a workspace. A 401 or 403 means the key was
+// rejected; any other status, or no answer at all, is returned as an error so
+// that an outage is not mistaken for a bad key.
+func (c *Client) IsAuthenticated(ctx context.Context) (bool, error) {
+	data, status, err := c.getWithStatus(ctx, "authenticate", nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to authenticate: %w", err)
+	}
+
+	switch status {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to authenticate: %w: http %d", ErrUnsuccessfulStatus, status)
+	}
+
+	res := &authenticateResponse{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	return res.WorkspaceName != "", nil
+}
+
+// Workspace only carries what the API exposes; plan and credit usage are not
+// available through it.
+type Workspace struct {
+	Name         string
+	AccountCount int
+}
+
+func (c *Client) GetWorkspaceInfo(ctx context.Context) (*Workspace, error) {
+	name, err := c.Authenticate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace info: %w", err)
+	}
+
+	accounts, _, err := c.listAllAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace info: %w", err)
+	}
+
+	return &Workspace{
+		Name:         name,
+		AccountCount: len(accounts),
+	}, nil
+}
+
+// WorkspaceStatus is a snapshot of the workspace for monitoring. Campaigns
+// counts every campaign, since campaign/list does not report which are
+// active, and credit usage is left out because the API does not expose it.
+type WorkspaceStatus struct {
+	Name              string
+	Campaigns         int
+	Accounts          int
+	HealthyAccounts   int
+	UnhealthyAccounts int
+}
+
+func (c *Client) WorkspaceStatus(ctx context.Context) (*WorkspaceStatus, error) {
+	name, err := c.Authenticate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace status: %w", err)
+	}
+
+	campaigns, err := c.ListAllCampaigns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace status: %w", err)
+	}
+
+	accounts, _, err := c.listAllAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace status: %w", err)
+	}
+
+	status := &WorkspaceStatus{
+		Name:      name,
+		Campaigns: len(campaigns),
+		Accounts:  len(accounts),
+	}
+	if len(accounts) == 0 {
+		return status, nil
+	}
+
+	emails := make([]string, len(accounts))
+	for i, account := range accounts {
+		emails[i] = account.Email
+	}
+
+	failing, err := c.failingAccounts(ctx, emails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace status: %w", err)
+	}
+
+	status.UnhealthyAccounts = len(failing)
+	status.HealthyAccounts = len(accounts) - len(failing)
+	return status, nil
 }
 
 type Campaign struct {
@@ -212,8 +1145,11 @@ type listCampaignsResponse []struct {
 	Name string `json:"name"`
 }
 
-func (c *Client) ListCampaigns() ([]Campaign, error) {
-	data, err := c.get("campaign/list", nil)
+func (c *Client) ListCampaigns(ctx context.Context, limit, skip int) ([]Campaign, error) {
+	data, err := c.get(ctx, "campaign/list", []query{
+		param("limit", strconv.Itoa(limit)),
+		param("skip", strconv.Itoa(skip)),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list campaigns: %w", err)
 	}
@@ -221,7 +1157,7 @@ func (c *Client) ListCampaigns() ([]Campaign, error) {
 	res := &listCampaignsResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
 	var campaigns []Campaign
@@ -235,13 +1171,40 @@ func (c *Client) ListCampaigns() ([]Campaign, error) {
 	return campaigns, nil
 }
 
+// ListAllCampaigns pages through every campaign. If ctx is done before the
+// last page, the campaigns listed so far are returned along with the error.
+func (c *Client) ListAllCampaigns(ctx context.Context) ([]Campaign, error) {
+	pageSize := c.options.pageSize
+
+	var campaigns []Campaign
+	for skip := 0; ; skip += pageSize {
+		err := ctx.Err()
+		if err != nil {
+			return campaigns, err
+		}
+
+		page, err := c.ListCampaigns(ctx, pageSize, skip)
+		if err != nil && ctx.Err() != nil {
+			return campaigns, err
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		campaigns = append(campaigns, page...)
+		if len(page) < pageSize {
+			return campaigns, nil
+		}
+	}
+}
+
 type getCampaignNameResponse struct {
 	Id   string `json:"campaign_id"`
 	Name string `json:"campaign_name"`
 }
 
-func (c *Client) GetCampaignName(campaignId string) (campaignName string, err error) {
-	data, err := c.get("campaign/get/name", []query{param("campaign_id", campaignId)})
+func (c *Client) GetCampaignName(ctx context.Context, campaignId string) (campaignName string, err error) {
+	data, err := c.get(ctx, "campaign/get/name", []query{param("campaign_id", campaignId)})
 	if err != nil {
 		return "", fmt.Errorf("failed to get campaign name: %w", err)
 	}
@@ -249,12 +1212,31 @@ func (c *Client) GetCampaignName(campaignId string) (campaignName string, err er
 	res := &getCampaignNameResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return "", ErrUnmarshalFailed
+		return "", fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
 	return res.Name, nil
 }
 
+// GetCampaignById returns the campaign with the given id, or
+// ErrCampaignNotFound. The API has no single-campaign endpoint, so this is
+// built from GetCampaignName.
+func (c *Client) GetCampaignById(ctx context.Context, campaignId string) (*Campaign, error) {
+	name, err := c.GetCampaignName(ctx, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("%w: %s", ErrCampaignNotFound, campaignId)
+	}
+
+	return &Campaign{
+		Id:   campaignId,
+		Name: name,
+	}, nil
+}
+
 type setCampaignNamePayload struct {
 	CampaignId string `json:"campaign_id"`
 	Name       string `json:"name"`
@@ -264,13 +1246,13 @@ type setCampaignNameResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) SetCampaignName(campaignId, campaignName string) error {
+func (c *Client) SetCampaignName(ctx context.Context, campaignId, campaignName string) error {
 	payload := setCampaignNamePayload{
 		CampaignId: campaignId,
 		Name:       campaignName,
 	}
 
-	data, err := c.post("campaign/set/name", payload)
+	data, err := c.post(ctx, "campaign/set/name", payload)
 	if err != nil {
 		return fmt.Errorf("failed to set campaign name: %w", err)
 	}
@@ -278,18 +1260,19 @@ func (c *Client) SetCampaignName(campaignId, campaignName string) error {
 	res := &setCampaignNameResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (c *Client) GetCampaignAccounts(campaignId string) (accountEmails []string, err error) {
-	data, err := c.get("campaign/get/accounts", []query{param("campaign_id", campaignId)})
+func (c *Client) GetCampaignAccounts(ctx context.Context, campaignId string) (accountEmails []string, err error) {
+	data, err := c.get(ctx, "campaign/get/accounts", []query{param("campaign_id", campaignId)})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get campaign accounts: %w", err)
 	}
@@ -297,12 +1280,148 @@ func (c *Client) GetCampaignAccounts(campaignId string) (accountEmails []string,
 	var res []string
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
 	return res, nil
 }
 
+func (c *Client) GetCampaignAccountsDetailed(ctx context.Context, campaignId string) ([]Account, error) {
+	emails, err := c.GetCampaignAccounts(ctx, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	// There is no per-campaign account listing, so match against every
+	// account in the workspace.
+	all, _, err := c.listAllAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign accounts: %w", err)
+	}
+
+	byEmail := make(map[string]Account, len(all))
+	for _, account := range all {
+		byEmail[account.Email] = account
+	}
+
+	accounts := make([]Account, 0, len(emails))
+	for _, email := range emails {
+		account, ok := byEmail[email]
+		if !ok {
+			return nil, fmt.Errorf("campaign account not found: %s", email)
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// AccountsHealthSummary counts a campaign's sending accounts by whether their
+// domain passes the vitals check. The API does not report per-account
+// connection or warmup state, so those are not broken out.
+type AccountsHealthSummary struct {
+	Total             int
+	Healthy           int
+	Unhealthy         int
+	UnhealthyAccounts []string
+}
+
+func (c *Client) GetCampaignAccountsHealth(ctx context.Context, campaignId string) (*AccountsHealthSummary, error) {
+	emails, err := c.GetCampaignAccounts(ctx, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AccountsHealthSummary{Total: len(emails)}
+	if len(emails) == 0 {
+		return summary, nil
+	}
+
+	failing, err := c.failingAccounts(ctx, emails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign accounts health: %w", err)
+	}
+
+	summary.Unhealthy = len(failing)
+	summary.Healthy = len(emails) - len(failing)
+	summary.UnhealthyAccounts = failing
+	return summary, nil
+}
+
+type AccountFilter int
+
+const (
+	AccountFilterAll AccountFilter = iota
+	AccountFilterHealthy
+	AccountFilterUnhealthy
+)
+
+// GetCampaignAccountsFiltered returns the campaign's sending accounts that
+// match filter, judging health by the vitals check as
+// GetCampaignAccountsHealth does. The API cannot filter, so every account is
+// still checked.
+func (c *Client) GetCampaignAccountsFiltered(ctx context.Context, campaignId string, filter AccountFilter) ([]string, error) {
+	emails, err := c.GetCampaignAccounts(ctx, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == AccountFilterAll || len(emails) == 0 {
+		return emails, nil
+	}
+	if filter != AccountFilterHealthy && filter != AccountFilterUnhealthy {
+		return nil, fmt.Errorf("invalid account filter: %d", filter)
+	}
+
+	failing, err := c.failingAccounts(ctx, emails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign accounts: %w", err)
+	}
+
+	if filter == AccountFilterUnhealthy {
+		return failing, nil
+	}
+
+	isFailing := make(map[string]bool, len(failing))
+	for _, email := range failing {
+		isFailing[email] = true
+	}
+
+	var healthy []string
+	for _, email := range emails {
+		if !isFailing[email] {
+			healthy = append(healthy, email)
+		}
+	}
+
+	return healthy, nil
+}
+
+// failingAccounts returns the accounts whose domain fails the vitals check.
+func (c *Client) failingAccounts(ctx context.Context, emails []string) ([]string, error) {
+	_, failureList, err := c.CheckAccountVitals(ctx, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	// Vitals are reported per domain, which accounts may share.
+	failingDomains := make(map[string]bool, len(failureList))
+	for _, vitals := range failureList {
+		failingDomains[strings.ToLower(vitals.Domain)] = true
+	}
+
+	var failing []string
+	for _, email := range emails {
+		_, domain, _ := strings.Cut(email, "@")
+		if failingDomains[strings.ToLower(domain)] {
+			failing = append(failing, email)
+		}
+	}
+
+	return failing, nil
+}
+
 type setCampaignAccountsPayload struct {
 	CampaignId  string   `json:"campaign_id"`
 	AccountList []string `json:"account_list"`
@@ -312,13 +1431,13 @@ type setCampaignAccountsResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) SetCampaignAccounts(campaignId string, accountEmails []string) error {
+func (c *Client) SetCampaignAccounts(ctx context.Context, campaignId string, accountEmails []string) error {
 	payload := setCampaignAccountsPayload{
 		CampaignId:  campaignId,
 		AccountList: accountEmails,
 	}
 
-	data, err := c.post("campaign/set/accounts", payload)
+	data, err := c.post(ctx, "campaign/set/accounts", payload)
 	if err != nil {
 		return fmt.Errorf("failed to set campaign accounts: %w", err)
 	}
@@ -326,11 +1445,12 @@ func (c *Client) SetCampaignAccounts(campaignId string, accountEmails []string)
 	res := &setCampaignAccountsResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -345,13 +1465,13 @@ type addSendingAccountResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) AddSendingAccount(campaignId, email string) error {
+func (c *Client) AddSendingAccount(ctx context.Context, campaignId, email string) error {
 	payload := addSendingAccountPayload{
 		CampaignId: campaignId,
 		Email:      email,
 	}
 
-	data, err := c.post("campaign/add/account", payload)
+	data, err := c.post(ctx, "campaign/add/account", payload)
 	if err != nil {
 		return fmt.Errorf("failed to add sending account: %w", err)
 	}
@@ -359,11 +1479,12 @@ func (c *Client) AddSendingAccount(campaignId, email string) error {
 	res := &addSendingAccountResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -378,13 +1499,13 @@ type removeSendingAccountResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) RemoveSendingAccount(campaignId, email string) error {
+func (c *Client) RemoveSendingAccount(ctx context.Context, campaignId, email string) error {
 	payload := removeSendingAccountPayload{
 		CampaignId: campaignId,
 		Email:      email,
 	}
 
-	data, err := c.post("campaign/remove/account", payload)
+	data, err := c.post(ctx, "campaign/remove/account", payload)
 	if err != nil {
 		return fmt.Errorf("failed to remove sending account: %w", err)
 	}
@@ -392,23 +1513,26 @@ func (c *Client) RemoveSendingAccount(campaignId, email string) error {
 	res := &removeSendingAccountResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
 type internalSetCampaignSchedulePayload struct {
-	CampaignId string     `json:"campaign_id"`
-	StartDate  time.Time  `json:"start_date"`
-	EndDate    *time.Time `json:"end_date,omitempty"`
-	Schedules  []CampaignSchedule
+	CampaignId string             `json:"campaign_id"`
+	StartDate  time.Time          `json:"start_date"`
+	EndDate    *time.Time         `json:"end_date,omitempty"`
+	Schedules  []CampaignSchedule `json:"schedules"`
 }
 
+// CampaignSchedule is not the wire format and is not meant to be marshaled;
+// SetCampaignSchedule converts it to the payload the API expects.
 type CampaignSchedule struct {
 	Name     string
 	Days     map[time.Weekday]bool
@@ -421,6 +1545,53 @@ type Timing struct {
 	To   time.Time
 }
 
+// NewWeekdaySchedule builds a schedule that sends on the given days between
+// from and to, both formatted as 15:04 in the schedule's timezone. A nil
+// timezone means UTC.
+func NewWeekdaySchedule(name string, days []time.Weekday, from, to string, tz *time.Location) (CampaignSchedule, error) {
+	if len(days) == 0 {
+		return CampaignSchedule{}, fmt.Errorf("invalid schedule %q: no days", name)
+	}
+
+	fromTime, err := time.Parse("15:04", from)
+	if err != nil {
+		return CampaignSchedule{}, fmt.Errorf("invalid schedule %q: bad from time: %w", name, err)
+	}
+
+	toTime, err := time.Parse("15:04", to)
+	if err != nil {
+		return CampaignSchedule{}, fmt.Errorf("invalid schedule %q: bad to time: %w", name, err)
+	}
+
+	if !fromTime.Before(toTime) {
+		return CampaignSchedule{}, fmt.Errorf("invalid schedule %q: from %s is not before to %s", name, from, to)
+	}
+
+	if tz == nil {
+		tz = time.UTC
+	}
+
+	schedule := CampaignSchedule{
+		Name:     name,
+		Days:     make(map[time.Weekday]bool, len(days)),
+		Timezone: tz,
+		Timing: Timing{
+			From: fromTime,
+			To:   toTime,
+		},
+	}
+
+	for _, day := range days {
+		if day < time.Sunday || day > time.Saturday {
+			return CampaignSchedule{}, fmt.Errorf("invalid schedule %q: bad day %d", name, day)
+		}
+
+		schedule.Days[day] = true
+	}
+
+	return schedule, nil
+}
+
 type setCampaignSchedulePayload struct {
 	CampaignId string             `json:"campaign_id"`
 	StartDate  string             `json:"start_date"`
@@ -441,6 +1612,11 @@ type timing struct {
 }
 
 func (p *internalSetCampaignSchedulePayload) convert() (*setCampaignSchedulePayload, error) {
+	err := p.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	payload := &setCampaignSchedulePayload{
 		CampaignId: p.CampaignId,
 		StartDate:  p.StartDate.Format("2006-01-02"),
@@ -473,11 +1649,126 @@ func (p *internalSetCampaignSchedulePayload) convert() (*setCampaignSchedulePayl
 	return payload, nil
 }
 
+type scheduleWindow struct {
+	name     string
+	from, to time.Time
+}
+
+func (w scheduleWindow) overlaps(other scheduleWindow) bool {
+	return w.from.Before(other.to) && other.from.Before(w.to)
+}
+
+func (w scheduleWindow) nextWeek() scheduleWindow {
+	return scheduleWindow{
+		name: w.name,
+		from: w.from.AddDate(0, 0, 7),
+		to:   w.to.AddDate(0, 0, 7),
+	}
+}
+
+// Instantly does not define which schedule wins when two of them allow
+// sending at the same instant, so overlapping windows are rejected instead of
+// silently picking one. Windows are compared as real instants, so schedules in
+// different timezones overlap if their hours coincide once converted.
+func (p *internalSetCampaignSchedulePayload) validate() error {
+	var windows [][]scheduleWindow
+	for _, schedule := range p.Schedules {
+		timezone := schedule.Timezone
+		if timezone == nil {
+			timezone = time.UTC
+		}
+
+		var scheduleWindows []scheduleWindow
+		for day, enabled := range schedule.Days {
+			if !enabled {
+				continue
+			}
+
+			// Anchor the window on the first matching weekday on or after the
+			// start date, so DST offsets are those in effect at launch.
+			offset := (int(day) - int(p.StartDate.Weekday()) + 7) % 7
+			date := p.StartDate.AddDate(0, 0, offset)
+			from := schedule.Timing.From
+			to := schedule.Timing.To
+			scheduleWindows = append(scheduleWindows, scheduleWindow{
+				name: schedule.Name,
+				from: time.Date(date.Year(), date.Month(), date.Day(), from.Hour(), from.Minute(), 0, 0, timezone),
+				to:   time.Date(date.Year(), date.Month(), date.Day(), to.Hour(), to.Minute(), 0, 0, timezone),
+			})
+		}
+
+		windows = append(windows, scheduleWindows)
+	}
+
+	for i := range windows {
+		for j := i + 1; j < len(windows); j++ {
+			for _, a := range windows[i] {
+				for _, b := range windows[j] {
+					// Windows near the end of the anchored week can overlap
+					// ones at the start of the following week.
+					if a.overlaps(b) || a.nextWeek().overlaps(b) || a.overlaps(b.nextWeek()) {
+						return fmt.Errorf("%w: %q and %q", ErrOverlappingSchedules, a.name, b.name)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// NextSendWindow returns the earliest instant at or after after at which any
+// of the schedules allows sending. If after falls inside a window, after
+// itself is returned.
+func NextSendWindow(schedules []CampaignSchedule, after time.Time) (time.Time, error) {
+	var next time.Time
+	for _, schedule := range schedules {
+		timezone := schedule.Timezone
+		if timezone == nil {
+			timezone = time.UTC
+		}
+
+		local := after.In(timezone)
+		// Look one day past a full week, in case today's window has passed
+		// and today is the only sending day.
+		for offset := 0; offset <= 7; offset++ {
+			date := local.AddDate(0, 0, offset)
+			if !schedule.Days[date.Weekday()] {
+				continue
+			}
+
+			from := schedule.Timing.From
+			to := schedule.Timing.To
+			start := time.Date(date.Year(), date.Month(), date.Day(), from.Hour(), from.Minute(), 0, 0, timezone)
+			end := time.Date(date.Year(), date.Month(), date.Day(), to.Hour(), to.Minute(), 0, 0, timezone)
+			if !end.After(after) {
+				continue
+			}
+
+			if start.Before(after) {
+				start = after
+			}
+
+			if next.IsZero() || start.Before(next) {
+				next = start
+			}
+
+			break
+		}
+	}
+
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("no sending window in schedules")
+	}
+
+	return next, nil
+}
+
 type setCampaignScheduleResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) SetCampaignSchedule(campaignId string, startDate time.Time, endDate *time.Time, schedules []CampaignSchedule) error {
+func (c *Client) SetCampaignSchedule(ctx context.Context, campaignId string, startDate time.Time, endDate *time.Time, schedules []CampaignSchedule) error {
 	internalPayload := &internalSetCampaignSchedulePayload{
 		CampaignId: campaignId,
 		StartDate:  startDate,
@@ -490,22 +1781,56 @@ func (c *Client) SetCampaignSchedule(campaignId string, startDate time.Time, end
 		return fmt.Errorf("failed to convert campaign schedule: %w", err)
 	}
 
-	data, err := c.post("campaign/set/schedules", payload)
-	if err != nil {
-		return fmt.Errorf("failed to set campaign schedule: %w", err)
-	}
+	data, err := c.post(ctx, "campaign/set/schedules", payload)
+	if err != nil {
+		return fmt.Errorf("failed to set campaign schedule: %w", err)
+	}
+
+	res := &setCampaignScheduleResponse{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadinessReport lists the problems that would keep a campaign from sending.
+// Only what the API exposes is checked: a campaign's sequence and schedule
+// cannot be read back, so their absence is not reported.
+type ReadinessReport struct {
+	Ready    bool
+	Problems []string
+}
+
+func (c *Client) CheckCampaignReadiness(ctx context.Context, campaignId string) (*ReadinessReport, error) {
+	report := &ReadinessReport{}
 
-	res := &setCampaignScheduleResponse{}
-	err = json.Unmarshal(data, res)
+	accounts, err := c.GetCampaignAccounts(ctx, campaignId)
 	if err != nil {
-		return ErrMarshalFailed
+		return nil, fmt.Errorf("failed to check campaign readiness: %w", err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	if len(accounts) == 0 {
+		report.Problems = append(report.Problems, "no sending accounts attached")
+	} else {
+		_, failureList, err := c.CheckAccountVitals(ctx, accounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check campaign readiness: %w", err)
+		}
+
+		for _, vitals := range failureList {
+			report.Problems = append(report.Problems, fmt.Sprintf("sending domain %s failed vitals check", vitals.Domain))
+		}
 	}
 
-	return nil
+	report.Ready = len(report.Problems) == 0
+	return report, nil
 }
 
 type launchCampaignPayload struct {
@@ -516,12 +1841,12 @@ type launchCampaignResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) LaunchCampaign(campaignId string) error {
+func (c *Client) LaunchCampaign(ctx context.Context, campaignId string) error {
 	payload := launchCampaignPayload{
 		CampaignId: campaignId,
 	}
 
-	data, err := c.post("campaign/launch", payload)
+	data, err := c.post(ctx, "campaign/launch", payload)
 	if err != nil {
 		return fmt.Errorf("failed to launch campaign: %w", err)
 	}
@@ -529,11 +1854,12 @@ func (c *Client) LaunchCampaign(campaignId string) error {
 	res := &launchCampaignResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -547,12 +1873,12 @@ type pauseCampaignResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) PauseCampaign(campaignId string) error {
+func (c *Client) PauseCampaign(ctx context.Context, campaignId string) error {
 	payload := pauseCampaignPayload{
 		CampaignId: campaignId,
 	}
 
-	data, err := c.post("campaign/pause", payload)
+	data, err := c.post(ctx, "campaign/pause", payload)
 	if err != nil {
 		return fmt.Errorf("failed to pause campaign: %w", err)
 	}
@@ -560,16 +1886,203 @@ func (c *Client) PauseCampaign(campaignId string) error {
 	res := &pauseCampaignResponse{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// PauseCampaignUntil pauses the campaign and launches it again at resumeAt.
+// ctx only governs the pause. The API cannot schedule a resume, so it is done
+// from a goroutine in this process and is lost if the process exits first.
+// The resume's result, nil or an error, is sent on resumed, which is then
+// closed; calling cancel before resumeAt calls the resume off, and sends
+// context.Canceled instead.
+func (c *Client) PauseCampaignUntil(ctx context.Context, campaignId string, resumeAt time.Time) (resumed <-chan error, cancel func(), err error) {
+	if !resumeAt.After(time.Now()) {
+		return nil, nil, fmt.Errorf("invalid resume time: %s is not in the future", resumeAt.Format(time.RFC3339))
+	}
+
+	err = c.PauseCampaign(ctx, campaignId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resumeCtx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+
+	go func() {
+		defer close(result)
+		defer cancel()
+
+		timer := time.NewTimer(time.Until(resumeAt))
+		defer timer.Stop()
+
+		select {
+		case <-resumeCtx.Done():
+			result <- resumeCtx.Err()
+			return
+		case <-timer.C:
+		}
+
+		result <- c.LaunchCampaign(resumeCtx, campaignId)
+	}()
+
+	return result, cancel, nil
+}
+
+// PauseAllCampaigns pauses every campaign in the workspace, carrying on past
+// failures. It returns the ids it paused and the error for each it could not;
+// if the campaigns cannot be listed, that error is keyed by the empty id.
+func (c *Client) PauseAllCampaigns(ctx context.Context) (paused []string, errs map[string]error) {
+	errs = make(map[string]error)
+
+	campaigns, err := c.ListAllCampaigns(ctx)
+	if err != nil {
+		errs[""] = err
+		return nil, errs
+	}
+
+	for _, campaign := range campaigns {
+		err = c.PauseCampaign(ctx, campaign.Id)
+		if err != nil {
+			errs[campaign.Id] = err
+			continue
+		}
+
+		paused = append(paused, campaign.Id)
+	}
+
+	return paused, errs
+}
+
+type CampaignStatus int
+
+const (
+	CampaignStatusDraft               CampaignStatus = 0
+	CampaignStatusActive              CampaignStatus = 1
+	CampaignStatusPaused              CampaignStatus = 2
+	CampaignStatusCompleted           CampaignStatus = 3
+	CampaignStatusRunningSubsequences CampaignStatus = 4
+	CampaignStatusAccountsUnhealthy   CampaignStatus = -1
+	CampaignStatusBounceProtect       CampaignStatus = -2
+	CampaignStatusAccountSuspended    CampaignStatus = -99
+)
+
+var campaignStatusNames = map[CampaignStatus]string{
+	CampaignStatusDraft:               "Draft",
+	CampaignStatusActive:              "Active",
+	CampaignStatusPaused:              "Paused",
+	CampaignStatusCompleted:           "Completed",
+	CampaignStatusRunningSubsequences: "Running Subsequences",
+	CampaignStatusAccountsUnhealthy:   "Accounts Unhealthy",
+	CampaignStatusBounceProtect:       "Bounce Protect",
+	CampaignStatusAccountSuspended:    "Account Suspended",
+}
+
+func (s CampaignStatus) String() string {
+	name, ok := campaignStatusNames[s]
+	if !ok {
+		return fmt.Sprintf("Unknown (%d)", int(s))
+	}
+
+	return name
+}
+
+// UnmarshalJSON accepts the status either as its numeric code or by name, in
+// any case, as campaign/get/status has returned both.
+func (s *CampaignStatus) UnmarshalJSON(data []byte) error {
+	var code int
+	err := json.Unmarshal(data, &code)
+	if err == nil {
+		*s = CampaignStatus(code)
+		return nil
+	}
+
+	var name string
+	err = json.Unmarshal(data, &name)
+	if err != nil {
+		return fmt.Errorf("campaign status is neither a number nor a string: %s", data)
+	}
+
+	code, err = strconv.Atoi(name)
+	if err == nil {
+		*s = CampaignStatus(code)
+		return nil
+	}
+
+	for status, statusName := range campaignStatusNames {
+		if strings.EqualFold(name, statusName) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown campaign status: %q", name)
+}
+
+type getCampaignStatusResponse struct {
+	CampaignId string         `json:"campaign_id"`
+	Status     CampaignStatus `json:"status"`
+}
+
+func (c *Client) GetCampaignStatus(ctx context.Context, campaignId string) (status CampaignStatus, err error) {
+	data, err := c.get(ctx, "campaign/get/status", []query{param("campaign_id", campaignId)})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get campaign status: %w", err)
+	}
+
+	res := &getCampaignStatusResponse{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	return res.Status, nil
+}
+
+// LaunchAllCampaigns launches every campaign in the workspace, or only the
+// paused ones if onlyPaused is set, carrying on past failures. Errors are
+// reported as in PauseAllCampaigns.
+func (c *Client) LaunchAllCampaigns(ctx context.Context, onlyPaused bool) (launched []string, errs map[string]error) {
+	errs = make(map[string]error)
+
+	campaigns, err := c.ListAllCampaigns(ctx)
+	if err != nil {
+		errs[""] = err
+		return nil, errs
+	}
+
+	for _, campaign := range campaigns {
+		if onlyPaused {
+			status, err := c.GetCampaignStatus(ctx, campaign.Id)
+			if err != nil {
+				errs[campaign.Id] = err
+				continue
+			}
+
+			if status != CampaignStatusPaused {
+				continue
+			}
+		}
+
+		err = c.LaunchCampaign(ctx, campaign.Id)
+		if err != nil {
+			errs[campaign.Id] = err
+			continue
+		}
+
+		launched = append(launched, campaign.Id)
+	}
+
+	return launched, errs
+}
+
 type getCampaignSummaryResponse struct {
 	CampaignID      string `json:"campaign_id"`
 	CampaignName    string `json:"campaign_name"`
@@ -582,21 +2095,40 @@ type getCampaignSummaryResponse struct {
 	Completed       int    `json:"completed"`
 }
 
-func (c *Client) GetCampaignSummary(campaignId string) (summary *getCampaignSummaryResponse, err error) {
-	data, err := c.get("campaign/summary", []query{param("campaign_id", campaignId)})
+// GetCampaignSummary reads analytics/campaign/summary, where the API serves
+// campaign summaries; there is no campaign/summary endpoint.
+func (c *Client) GetCampaignSummary(ctx context.Context, campaignId string) (*getCampaignSummaryResponse, error) {
+	data, err := c.get(ctx, "analytics/campaign/summary", []query{param("campaign_id", campaignId)})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get campaign summary: %w", err)
 	}
 
+	summary := &getCampaignSummaryResponse{}
 	err = json.Unmarshal(data, summary)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
 	return summary, nil
 }
 
-type getCampaignCountResponse struct {
+// GetCampaignProgress returns the fraction of the campaign's leads that have
+// completed the sequence, from 0 to 1. A campaign without leads has made no
+// progress.
+func (c *Client) GetCampaignProgress(ctx context.Context, campaignId string) (float64, error) {
+	summary, err := c.GetCampaignSummary(ctx, campaignId)
+	if err != nil {
+		return 0, err
+	}
+
+	if summary.TotalLeads == 0 {
+		return 0, nil
+	}
+
+	return float64(summary.Completed) / float64(summary.TotalLeads), nil
+}
+
+type CampaignCount struct {
 	CampaignID        string `json:"campaign_id"`
 	CampaignName      string `json:"campaign_name"`
 	TotalEmailsSent   int    `json:"total_emails_sent"`
@@ -606,36 +2138,186 @@ type getCampaignCountResponse struct {
 	LeadsRead         int    `json:"leads_read"`
 }
 
-func (c *Client) GetCampaignCount(campaignId string, startDate time.Time, endDate *time.Time) (count *getCampaignCountResponse, err error) {
-	// Convert time.Time to string.
-	startDateStr := startDate.Format("01-02-2006")
-	endDateStr := ""
-	if endDate != nil {
-		endDateStr = endDate.Format("01-02-2006")
+// OpenRate is the percentage of contacted leads that opened an email.
+func (c CampaignCount) OpenRate() float64 {
+	return percentage(c.LeadsRead, c.NewLeadsContacted)
+}
+
+// ReplyRate is the percentage of contacted leads that replied.
+func (c CampaignCount) ReplyRate() float64 {
+	return percentage(c.LeadsReplied, c.NewLeadsContacted)
+}
+
+// ReadRate is the percentage of sent emails that were read.
+func (c CampaignCount) ReadRate() float64 {
+	return percentage(c.EmailsRead, c.TotalEmailsSent)
+}
+
+// percentage returns 0 rather than NaN when nothing has been counted yet.
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
 	}
 
-	queries := []query{
-		param("campaign_id", campaignId),
-		param("start_date", startDateStr),
+	return 100 * float64(part) / float64(total)
+}
+
+// DateRange is an inclusive range of UTC days for analytics methods. A zero
+// End leaves the range open-ended.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func NewDateRange(start, end time.Time) (DateRange, error) {
+	if start.IsZero() {
+		return DateRange{}, fmt.Errorf("invalid date range: missing start")
+	}
+	if !end.IsZero() && end.Before(start) {
+		return DateRange{}, fmt.Errorf("invalid date range: end %s is before start %s", end.Format("2006-01-02"), start.Format("2006-01-02"))
 	}
-	if endDateStr != "" {
-		queries = append(queries, param("end_date", endDateStr))
+
+	return DateRange{Start: start, End: end}, nil
+}
+
+// Analytics endpoints take dates as MM-DD-YYYY, unlike the YYYY-MM-DD used
+// by campaign schedules, and count days in UTC. Bounds are converted to UTC
+// first, so an instant late in the evening west of Greenwich falls on the
+// next UTC day rather than on its local date.
+func (r DateRange) queries() []query {
+	queries := []query{param("start_date", r.Start.UTC().Format("01-02-2006"))}
+	if !r.End.IsZero() {
+		queries = append(queries, param("end_date", r.End.UTC().Format("01-02-2006")))
 	}
 
-	data, err := c.get("analytics/campaign/count", queries)
+	return queries
+}
+
+func (c *Client) GetCampaignCount(ctx context.Context, campaignId string, dates DateRange) (*CampaignCount, error) {
+	return c.campaignCount(ctx, []query{param("campaign_id", campaignId)}, dates)
+}
+
+// campaignCount counts across every campaign when queries carries no
+// campaign_id.
+func (c *Client) campaignCount(ctx context.Context, queries []query, dates DateRange) (*CampaignCount, error) {
+	dates, err := NewDateRange(dates.Start, dates.End)
+	if err != nil {
+		return nil, err
+	}
 
+	queries = append(queries, dates.queries()...)
+	data, err := c.get(ctx, "analytics/campaign/count", queries)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get campaign count: %w", err)
 	}
 
+	count := &CampaignCount{}
 	err = json.Unmarshal(data, count)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
 	return count, nil
 }
 
+type DailyVolume struct {
+	Date time.Time
+	Sent int
+}
+
+// GetWorkspaceSendingVolume returns the emails sent across all campaigns on
+// each UTC day from start to end inclusive, one request per day.
+func (c *Client) GetWorkspaceSendingVolume(ctx context.Context, start, end time.Time) ([]DailyVolume, error) {
+	dates, err := NewDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	if dates.End.IsZero() {
+		return nil, fmt.Errorf("invalid date range: missing end")
+	}
+
+	first := dates.Start.UTC().Truncate(24 * time.Hour)
+	last := dates.End.UTC().Truncate(24 * time.Hour)
+
+	var volumes []DailyVolume
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		count, err := c.campaignCount(ctx, nil, DateRange{Start: day, End: day})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workspace sending volume: %w", err)
+		}
+
+		volumes = append(volumes, DailyVolume{
+			Date: day,
+			Sent: count.TotalEmailsSent,
+		})
+	}
+
+	return volumes, nil
+}
+
+type Granularity int
+
+const (
+	GranularityDaily Granularity = iota
+	GranularityWeekly
+)
+
+// RatePoint is a rate, as a percentage, over the period starting at Date.
+type RatePoint struct {
+	Date time.Time
+	Rate float64
+}
+
+// GetReplyRateTrend returns the campaign's reply rate, as computed by
+// CampaignCount.ReplyRate, for each UTC day or each week from start to end
+// inclusive. Weeks start on the day of start, and the last one is cut short
+// at end. It makes one request per period.
+func (c *Client) GetReplyRateTrend(ctx context.Context, campaignId string, start, end time.Time, granularity Granularity) ([]RatePoint, error) {
+	var step int
+	switch granularity {
+	case GranularityDaily:
+		step = 1
+	case GranularityWeekly:
+		step = 7
+	default:
+		return nil, fmt.Errorf("invalid granularity: %d", granularity)
+	}
+
+	dates, err := NewDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	if dates.End.IsZero() {
+		return nil, fmt.Errorf("invalid date range: missing end")
+	}
+
+	first := dates.Start.UTC().Truncate(24 * time.Hour)
+	last := dates.End.UTC().Truncate(24 * time.Hour)
+
+	var points []RatePoint
+	for period := first; !period.After(last); period = period.AddDate(0, 0, step) {
+		periodEnd := period.AddDate(0, 0, step-1)
+		if periodEnd.After(last) {
+			periodEnd = last
+		}
+
+		count, err := c.campaignCount(ctx, []query{param("campaign_id", campaignId)}, DateRange{Start: period, End: periodEnd})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reply rate trend: %w", err)
+		}
+
+		points = append(points, RatePoint{
+			Date: period,
+			Rate: count.ReplyRate(),
+		})
+	}
+
+	return points, nil
+}
+
+// Lead is a lead as uploaded by AddLeadsToCampaign. lead/add expects custom
+// variables nested under custom_variables rather than merged into the lead
+// object, so the default encoding is the wire format.
 type Lead struct {
 	Email           string            `json:"email"`
 	FirstName       string            `json:"first_name,omitempty"`
@@ -647,45 +2329,220 @@ type Lead struct {
 	CustomVariables map[string]string `json:"custom_variables,omitempty"`
 }
 
+// LeadBuilder builds a Lead field by field. Start one with NewLead.
+type LeadBuilder struct {
+	lead Lead
+}
+
+func NewLead(email string) *LeadBuilder {
+	return &LeadBuilder{lead: Lead{Email: email}}
+}
+
+func (b *LeadBuilder) WithFirstName(firstName string) *LeadBuilder {
+	b.lead.FirstName = firstName
+	return b
+}
+
+func (b *LeadBuilder) WithLastName(lastName string) *LeadBuilder {
+	b.lead.LastName = lastName
+	return b
+}
+
+func (b *LeadBuilder) WithCompanyName(companyName string) *LeadBuilder {
+	b.lead.CompanyName = companyName
+	return b
+}
+
+func (b *LeadBuilder) WithPersonalization(personalization string) *LeadBuilder {
+	b.lead.Personalization = personalization
+	return b
+}
+
+func (b *LeadBuilder) WithPhone(phone string) *LeadBuilder {
+	b.lead.Phone = phone
+	return b
+}
+
+func (b *LeadBuilder) WithWebsite(website string) *LeadBuilder {
+	b.lead.Website = website
+	return b
+}
+
+func (b *LeadBuilder) WithCustomVar(key, value string) *LeadBuilder {
+	if b.lead.CustomVariables == nil {
+		b.lead.CustomVariables = make(map[string]string)
+	}
+
+	b.lead.CustomVariables[key] = value
+	return b
+}
+
+// Build returns the lead, or an error if its email is not a bare address. The
+// builder can be reused; later calls do not change leads already built.
+func (b *LeadBuilder) Build() (Lead, error) {
+	if !validEmail(b.lead.Email) {
+		return Lead{}, fmt.Errorf("invalid lead email: %q", b.lead.Email)
+	}
+
+	lead := b.lead
+	if b.lead.CustomVariables != nil {
+		lead.CustomVariables = make(map[string]string, len(b.lead.CustomVariables))
+		for key, value := range b.lead.CustomVariables {
+			lead.CustomVariables[key] = value
+		}
+	}
+
+	return lead, nil
+}
+
 type addLeadsToCampaignPayload struct {
 	CampaignId string `json:"campaign_id"`
 	Leads      []Lead `json:"leads"`
 }
 
 type addLeadsToCampaignResponse struct {
-	Status              string `json:"status"`
-	TotalSent           int    `json:"total_sent"`
-	LeadsUploaded       int    `json:"leads_uploaded"`
-	AlreadyInCampaign   string `json:"already_in_campaign"`
-	InvalidEmailCount   string `json:"invalid_email_count"`
-	DuplicateEmailCount string `json:"duplicate_email_count"`
-	RemainingInPlan     int    `json:"remaining_in_plan"`
+	Status              string   `json:"status"`
+	TotalSent           looseInt `json:"total_sent"`
+	LeadsUploaded       looseInt `json:"leads_uploaded"`
+	AlreadyInCampaign   looseInt `json:"already_in_campaign"`
+	InvalidEmailCount   looseInt `json:"invalid_email_count"`
+	DuplicateEmailCount looseInt `json:"duplicate_email_count"`
+	RemainingInPlan     looseInt `json:"remaining_in_plan"`
+}
+
+// looseInt decodes an integer sent either as a JSON number or as a string
+// holding one, which the API does inconsistently across fields.
+type looseInt int
+
+func (n *looseInt) UnmarshalJSON(data []byte) error {
+	var number int
+	err := json.Unmarshal(data, &number)
+	if err == nil {
+		*n = looseInt(number)
+		return nil
+	}
+
+	var str string
+	err = json.Unmarshal(data, &str)
+	if err != nil {
+		return fmt.Errorf("integer is neither a number nor a string: %s", data)
+	}
+
+	if str == "" {
+		*n = 0
+		return nil
+	}
+
+	number, err = strconv.Atoi(str)
+	if err != nil {
+		return fmt.Errorf("invalid integer %q: %w", str, err)
+	}
+
+	*n = looseInt(number)
+	return nil
 }
 
-func (c *Client) AddLeadsToCampaign(campaignId string, leads []Lead) (response *addLeadsToCampaignResponse, err error) {
+type LeadUploadResult struct {
+	TotalSent           int
+	LeadsUploaded       int
+	AlreadyInCampaign   int
+	InvalidEmailCount   int
+	DuplicateEmailCount int
+	RemainingInPlan     int
+}
+
+func (c *Client) AddLeadsToCampaign(ctx context.Context, campaignId string, leads []Lead) (*LeadUploadResult, error) {
 	payload := addLeadsToCampaignPayload{
 		CampaignId: campaignId,
 		Leads:      leads,
 	}
 
-	data, err := c.post("lead/add", payload)
+	data, err := c.post(ctx, "lead/add", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add leads to campaign: %w", err)
 	}
 
-	err = json.Unmarshal(data, response)
+	res := &addLeadsToCampaignResponse{}
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	err = parseStatus(res.Status)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, err
+	}
+
+	return &LeadUploadResult{
+		TotalSent:           int(res.TotalSent),
+		LeadsUploaded:       int(res.LeadsUploaded),
+		AlreadyInCampaign:   int(res.AlreadyInCampaign),
+		InvalidEmailCount:   int(res.InvalidEmailCount),
+		DuplicateEmailCount: int(res.DuplicateEmailCount),
+		RemainingInPlan:     int(res.RemainingInPlan),
+	}, nil
+}
+
+// UploadPreview sorts the emails of a planned upload the way lead/add would.
+// Duplicate holds repeats of an email earlier in the same upload.
+type UploadPreview struct {
+	New       []string
+	Existing  []string
+	Invalid   []string
+	Duplicate []string
+}
+
+// PreviewLeadUpload reports what AddLeadsToCampaign would do with leads
+// without uploading them. There is no preview endpoint, so each valid, unique
+// email is looked up in the campaign to find the existing ones.
+func (c *Client) PreviewLeadUpload(ctx context.Context, campaignId string, leads []Lead) (*UploadPreview, error) {
+	preview := &UploadPreview{}
+	seen := make(map[string]bool, len(leads))
+	for _, lead := range leads {
+		err := ctx.Err()
+		if err != nil {
+			return nil, err
+		}
+
+		email := strings.ToLower(strings.TrimSpace(lead.Email))
+		switch {
+		case !validEmail(email):
+			preview.Invalid = append(preview.Invalid, lead.Email)
+		case seen[email]:
+			preview.Duplicate = append(preview.Duplicate, lead.Email)
+		default:
+			_, err := c.GetLeadFromCampaign(ctx, campaignId, email)
+			switch {
+			case errors.Is(err, ErrLeadNotFound):
+				preview.New = append(preview.New, lead.Email)
+			case err != nil:
+				return nil, fmt.Errorf("failed to preview lead upload: %w", err)
+			default:
+				preview.Existing = append(preview.Existing, lead.Email)
+			}
+		}
+		seen[email] = true
 	}
 
-	return response, nil
+	return preview, nil
 }
 
-type internalLead struct {
+// validEmail reports whether s is a bare address, without a display name.
+func validEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// CampaignLead is a lead as stored in a campaign. Status is its sequence
+// status code; see LeadStatusFromCode. LeadStatus is the same status by name,
+// and also keeps statuses sent by name that have no code, such as
+// Interested, for which Status is 0.
+type CampaignLead struct {
 	Id           string            `json:"id"`
 	Timestamp    time.Time         `json:"timestamp_created"`
 	Campaign     string            `json:"campaign"`
 	Status       int               `json:"status"`
+	LeadStatus   LeadStatus        `json:"lead_status"`
 	Contact      string            `json:"contact"`
 	EmailOpened  bool              `json:"email_opened"`
 	EmailReplied bool              `json:"email_replied"`
@@ -693,11 +2550,49 @@ type internalLead struct {
 	CampaignName string            `json:"campaign_name"`
 }
 
-type getLeadFromCampaignResponse []struct {
+// String returns the lead data value for key, and whether it is set.
+func (l CampaignLead) String(key string) (string, bool) {
+	value, ok := l.LeadData[key]
+	return value, ok
+}
+
+// Int returns the lead data value for key parsed as an integer, and whether
+// it is set and parses.
+func (l CampaignLead) Int(key string) (int, bool) {
+	value, ok := l.LeadData[key]
+	if !ok {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// Bool returns the lead data value for key parsed as a boolean, and whether
+// it is set and parses.
+func (l CampaignLead) Bool(key string) (bool, bool) {
+	value, ok := l.LeadData[key]
+	if !ok {
+		return false, false
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return false, false
+	}
+
+	return b, true
+}
+
+type campaignLead struct {
 	Id           string            `json:"id"`
 	Timestamp    string            `json:"timestamp_created"`
 	Campaign     string            `json:"campaign"`
-	Status       int               `json:"status"`
+	Status       leadStatusCode    `json:"status"`
 	Contact      string            `json:"contact"`
 	EmailOpened  bool              `json:"email_opened"`
 	EmailReplied bool              `json:"email_replied"`
@@ -705,8 +2600,29 @@ type getLeadFromCampaignResponse []struct {
 	CampaignName string            `json:"campaign_name"`
 }
 
-func (c *Client) GetLeadFromCampaign(campaignId, email string) (lead internalLead, err error) {
-	data, err := c.get("lead/get", []query{param("campaign_id", campaignId), param("email", email)})
+func (l *campaignLead) convert(logger *log.Logger) CampaignLead {
+	if l.Status.name != "" && !l.Status.known {
+		logger.Printf("instantly: unrecognized lead status %q", l.Status.name)
+	}
+
+	return CampaignLead{
+		Id:           l.Id,
+		Timestamp:    parseTimestamp(logger, "timestamp_created", l.Timestamp),
+		Campaign:     l.Campaign,
+		Status:       l.Status.code,
+		LeadStatus:   l.Status.name,
+		Contact:      l.Contact,
+		EmailOpened:  l.EmailOpened,
+		EmailReplied: l.EmailReplied,
+		LeadData:     l.LeadData,
+		CampaignName: l.CampaignName,
+	}
+}
+
+type getLeadFromCampaignResponse []campaignLead
+
+func (c *Client) GetLeadFromCampaign(ctx context.Context, campaignId, email string) (lead CampaignLead, err error) {
+	data, err := c.get(ctx, "lead/get", []query{param("campaign_id", campaignId), param("email", email)})
 	if err != nil {
 		return lead, fmt.Errorf("failed to get lead from campaign: %w", err)
 	}
@@ -714,36 +2630,147 @@ func (c *Client) GetLeadFromCampaign(campaignId, email string) (lead internalLea
 	res := getLeadFromCampaignResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return lead, ErrUnmarshalFailed
+		return lead, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	if len(res) == 0 {
+		return lead, ErrLeadNotFound
+	}
+
+	if len(res) > 1 {
+		return lead, fmt.Errorf("multiple leads found")
+	}
+
+	return res[0].convert(c.options.logger), nil
+}
+
+// FindLeadAcrossCampaigns returns the lead's record in every campaign it
+// belongs to, looking it up in each campaign of the workspace in turn.
+func (c *Client) FindLeadAcrossCampaigns(ctx context.Context, email string) ([]CampaignLead, error) {
+	campaigns, err := c.ListAllCampaigns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find lead across campaigns: %w", err)
+	}
+
+	var leads []CampaignLead
+	for _, campaign := range campaigns {
+		lead, err := c.GetLeadFromCampaign(ctx, campaign.Id, email)
+		if errors.Is(err, ErrLeadNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find lead across campaigns: %w", err)
+		}
+
+		leads = append(leads, lead)
+	}
+
+	return leads, nil
+}
+
+// leadFromCampaignLead rebuilds an uploadable lead from a stored one. Lead
+// data holds the standard fields alongside custom variables, keyed as they
+// were uploaded.
+func leadFromCampaignLead(campaignLead CampaignLead) Lead {
+	lead := Lead{
+		Email:           campaignLead.Contact,
+		CustomVariables: make(map[string]string),
+	}
+
+	for key, value := range campaignLead.LeadData {
+		switch key {
+		case "email":
+		case "first_name":
+			lead.FirstName = value
+		case "last_name":
+			lead.LastName = value
+		case "company_name":
+			lead.CompanyName = value
+		case "personalization":
+			lead.Personalization = value
+		case "phone":
+			lead.Phone = value
+		case "website":
+			lead.Website = value
+		default:
+			lead.CustomVariables[key] = value
+		}
 	}
 
-	if len(res) == 0 {
-		return lead, fmt.Errorf("no lead found")
+	return lead
+}
+
+// MoveLeadsToCampaign copies the given leads, with their data, into another
+// campaign and then deletes them from the source. There is no move endpoint,
+// so each lead is first looked up in the source campaign, and the leads are
+// then added and deleted in chunks. Nothing is changed if any email is not in
+// the source campaign. A chunk is deleted from the source only once every
+// lead in it is in the destination; if one is not, an error is returned and
+// the chunks before it stay moved. Leads start afresh in the destination:
+// their status, such as Interested, is not carried over.
+func (c *Client) MoveLeadsToCampaign(ctx context.Context, fromCampaignId, toCampaignId string, emails []string) error {
+	const chunkSize = 500
+
+	var leads []Lead
+	missing := 0
+	seen := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		// Skip repeats of an email, ignoring case and surrounding space, as
+		// PreviewLeadUpload does.
+		email = strings.TrimSpace(email)
+		key := strings.ToLower(email)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		campaignLead, err := c.GetLeadFromCampaign(ctx, fromCampaignId, email)
+		if errors.Is(err, ErrLeadNotFound) {
+			missing++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move leads: %w", err)
+		}
+
+		leads = append(leads, leadFromCampaignLead(campaignLead))
 	}
 
-	if len(res) > 1 {
-		return lead, fmt.Errorf("multiple leads found")
+	if missing > 0 {
+		return fmt.Errorf("failed to move leads: %d leads not found in campaign %s", missing, fromCampaignId)
 	}
 
-	// Convert timestamp to time.Time.
-	timestamp, err := time.Parse(time.RFC3339, res[0].Timestamp)
-	if err != nil {
-		return lead, fmt.Errorf("failed to parse timestamp: %w", err)
-	}
+	for start := 0; start < len(leads); start += chunkSize {
+		end := start + chunkSize
+		if end > len(leads) {
+			end = len(leads)
+		}
+		chunk := leads[start:end]
 
-	lead = internalLead{
-		Id:           res[0].Id,
-		Timestamp:    timestamp,
-		Campaign:     res[0].Campaign,
-		Status:       res[0].Status,
-		Contact:      res[0].Contact,
-		EmailOpened:  res[0].EmailOpened,
-		EmailReplied: res[0].EmailReplied,
-		LeadData:     res[0].LeadData,
-		CampaignName: res[0].CampaignName,
+		result, err := c.AddLeadsToCampaign(ctx, toCampaignId, chunk)
+		if err != nil {
+			return fmt.Errorf("failed to move leads: %w", err)
+		}
+
+		// Leave the source alone unless every lead made it across, or the
+		// ones the destination rejected would be lost.
+		if result.LeadsUploaded+result.AlreadyInCampaign != len(chunk) {
+			added := result.LeadsUploaded + result.AlreadyInCampaign
+			return fmt.Errorf("failed to move leads: only %d of %d leads added to campaign %s", added, len(chunk), toCampaignId)
+		}
+
+		chunkEmails := make([]string, len(chunk))
+		for i, lead := range chunk {
+			chunkEmails[i] = lead.Email
+		}
+
+		err = c.DeleteLeadsFromCampaign(ctx, fromCampaignId, false, chunkEmails)
+		if err != nil {
+			return fmt.Errorf("failed to move leads: %w", err)
+		}
 	}
 
-	return lead, nil
+	return nil
 }
 
 type deleteLeadsFromCampaignPayload struct {
@@ -755,14 +2782,14 @@ type deleteLeadsFromCampaignResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) DeleteLeadsFromCampaign(campaignId string, deleteAllFromCompany bool, deleteList []string) error {
+func (c *Client) DeleteLeadsFromCampaign(ctx context.Context, campaignId string, deleteAllFromCompany bool, deleteList []string) error {
 	payload := deleteLeadsFromCampaignPayload{
 		CampaignId:           campaignId,
 		DeleteAllFromCompany: deleteAllFromCompany,
 		DeleteList:           deleteList,
 	}
 
-	data, err := c.post("lead/delete", payload)
+	data, err := c.post(ctx, "lead/delete", payload)
 	if err != nil {
 		return fmt.Errorf("failed to delete leads from campaign: %w", err)
 	}
@@ -770,47 +2797,138 @@ func (c *Client) DeleteLeadsFromCampaign(campaignId string, deleteAllFromCompany
 	res := deleteLeadsFromCampaignResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
 type updateLeadStatusPayload struct {
-	CampaignId string `json:"campaign_id"`
-	Email      string `json:"email"`
-	NewStatus  string `json:"new_status"`
+	CampaignId string     `json:"campaign_id"`
+	Email      string     `json:"email"`
+	NewStatus  LeadStatus `json:"new_status"`
 }
 
 type updateLeadStatusResponse struct {
 	Status string `json:"status"`
 }
 
+type LeadStatus string
+
 const (
-	LeadStatusActive          = "Active"
-	LeadStatusCompleted       = "Completed"
-	LeadStatusUnsubscribed    = "Unsubscribed"
-	LeadStatusInterested      = "Interested"
-	LeadStatusMeetingBooked   = "Meeting Booked"
-	LeadStatusMeetingComplete = "Meeting Completed"
-	LeadStatusClosed          = "Closed"
-	LeadStatusOutOfOffice     = "Out of Office"
-	LeadStatusNotInterested   = "Not Interested"
-	LeadStatusWrongPerson     = "Wrong Person"
+	LeadStatusActive          LeadStatus = "Active"
+	LeadStatusCompleted       LeadStatus = "Completed"
+	LeadStatusUnsubscribed    LeadStatus = "Unsubscribed"
+	LeadStatusInterested      LeadStatus = "Interested"
+	LeadStatusMeetingBooked   LeadStatus = "Meeting Booked"
+	LeadStatusMeetingComplete LeadStatus = "Meeting Completed"
+	LeadStatusClosed          LeadStatus = "Closed"
+	LeadStatusOutOfOffice     LeadStatus = "Out of Office"
+	LeadStatusNotInterested   LeadStatus = "Not Interested"
+	LeadStatusWrongPerson     LeadStatus = "Wrong Person"
 )
 
-func (c *Client) UpdateLeadStatus(campaignId, email, status string) error {
+// Statuses a lead can be in but that cannot be set with UpdateLeadStatus.
+const (
+	LeadStatusPaused  LeadStatus = "Paused"
+	LeadStatusBounced LeadStatus = "Bounced"
+	LeadStatusSkipped LeadStatus = "Skipped"
+)
+
+var leadStatuses = []LeadStatus{
+	LeadStatusActive,
+	LeadStatusCompleted,
+	LeadStatusUnsubscribed,
+	LeadStatusInterested,
+	LeadStatusMeetingBooked,
+	LeadStatusMeetingComplete,
+	LeadStatusClosed,
+	LeadStatusOutOfOffice,
+	LeadStatusNotInterested,
+	LeadStatusWrongPerson,
+	LeadStatusPaused,
+	LeadStatusBounced,
+	LeadStatusSkipped,
+}
+
+// Leads report their sequence status as an integer code.
+var leadStatusCodes = map[int]LeadStatus{
+	1:  LeadStatusActive,
+	2:  LeadStatusPaused,
+	3:  LeadStatusCompleted,
+	-1: LeadStatusBounced,
+	-2: LeadStatusUnsubscribed,
+	-3: LeadStatusSkipped,
+}
+
+// leadStatusCode decodes a lead's status from its numeric code, which
+// lead/get returns, or from a code or status name sent as a string, as other
+// lead endpoints do. A name with no code, such as "Interested", decodes to
+// code 0 with the name kept, rather than failing the whole response. Names
+// that match no LeadStatus are kept as sent, with known false.
+type leadStatusCode struct {
+	code  int
+	name  LeadStatus
+	known bool
+}
+
+func (c *leadStatusCode) UnmarshalJSON(data []byte) error {
+	var code looseInt
+	err := json.Unmarshal(data, &code)
+	if err == nil {
+		*c = leadStatusCode{code: int(code), name: LeadStatusFromCode(int(code)), known: true}
+		return nil
+	}
+
+	var name string
+	if json.Unmarshal(data, &name) != nil {
+		return err
+	}
+
+	for code, status := range leadStatusCodes {
+		if strings.EqualFold(name, string(status)) {
+			*c = leadStatusCode{code: code, name: status, known: true}
+			return nil
+		}
+	}
+
+	for _, status := range leadStatuses {
+		if strings.EqualFold(name, string(status)) {
+			*c = leadStatusCode{name: status, known: true}
+			return nil
+		}
+	}
+
+	*c = leadStatusCode{name: LeadStatus(name)}
+	return nil
+}
+
+// LeadStatusFromCode maps a lead's sequence status code, as in
+// CampaignLead.Status, to its LeadStatus: one of Active, Paused, Completed,
+// Bounced, Unsubscribed or Skipped. Interest statuses such as Interested
+// have no code and are never returned.
+func LeadStatusFromCode(code int) LeadStatus {
+	status, ok := leadStatusCodes[code]
+	if !ok {
+		return LeadStatus(fmt.Sprintf("Unknown (%d)", code))
+	}
+
+	return status
+}
+
+func (c *Client) UpdateLeadStatus(ctx context.Context, campaignId, email string, status LeadStatus) error {
 	payload := updateLeadStatusPayload{
 		CampaignId: campaignId,
 		Email:      email,
 		NewStatus:  status,
 	}
 
-	data, err := c.post("lead/update/status", payload)
+	data, err := c.post(ctx, "lead/update/status", payload)
 	if err != nil {
 		return fmt.Errorf("failed to update lead status: %w", err)
 	}
@@ -818,11 +2936,30 @@ func (c *Client) UpdateLeadStatus(campaignId, email, status string) error {
 	res := updateLeadStatusResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	return nil
+}
+
+// UnsubscribeLead marks the lead as unsubscribed in the campaign and adds its
+// email to the workspace blocklist. Setting LeadStatusUnsubscribed through
+// UpdateLeadStatus only stops this campaign; the blocklist entry also keeps
+// the address out of every other campaign, current and future.
+func (c *Client) UnsubscribeLead(ctx context.Context, campaignId, email string) error {
+	err := c.UpdateLeadStatus(ctx, campaignId, email, LeadStatusUnsubscribed)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe lead: %w", err)
+	}
+
+	_, err = c.AddEntriesToBlocklist(ctx, []string{email})
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe lead: %w", err)
 	}
 
 	return nil
@@ -838,14 +2975,14 @@ type updateLeadVariableResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) UpdateLeadVariable(campaignId, email string, variables map[string]interface{}) error {
+func (c *Client) UpdateLeadVariable(ctx context.Context, campaignId, email string, variables map[string]interface{}) error {
 	payload := updateLeadVariablePayload{
 		CampaignId: campaignId,
 		Email:      email,
 		Variables:  variables,
 	}
 
-	data, err := c.post("lead/data/update", payload)
+	data, err := c.post(ctx, "lead/data/update", payload)
 	if err != nil {
 		return fmt.Errorf("failed to update lead variable: %w", err)
 	}
@@ -853,11 +2990,12 @@ func (c *Client) UpdateLeadVariable(campaignId, email string, variables map[stri
 	res := updateLeadVariableResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -873,14 +3011,14 @@ type setLeadVariableResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) SetLeadVariable(campaignId, email string, variables map[string]interface{}) error {
+func (c *Client) SetLeadVariable(ctx context.Context, campaignId, email string, variables map[string]interface{}) error {
 	payload := setLeadVariablePayload{
 		CampaignId: campaignId,
 		Email:      email,
 		Variables:  variables,
 	}
 
-	data, err := c.post("lead/data/set", payload)
+	data, err := c.post(ctx, "lead/data/set", payload)
 	if err != nil {
 		return fmt.Errorf("failed to set lead variable: %w", err)
 	}
@@ -888,11 +3026,12 @@ func (c *Client) SetLeadVariable(campaignId, email string, variables map[string]
 	res := setLeadVariableResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -908,14 +3047,14 @@ type deleteLeadVariablesResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) DeleteLeadVariables(campaignId, email string, variables []string) error {
+func (c *Client) DeleteLeadVariables(ctx context.Context, campaignId, email string, variables []string) error {
 	payload := deleteLeadVariablesPayload{
 		CampaignId: campaignId,
 		Email:      email,
 		Variables:  variables,
 	}
 
-	data, err := c.post("lead/data/update", payload)
+	data, err := c.post(ctx, "lead/data/delete", payload)
 	if err != nil {
 		return fmt.Errorf("failed to delete lead variables: %w", err)
 	}
@@ -923,11 +3062,94 @@ func (c *Client) DeleteLeadVariables(campaignId, email string, variables []strin
 	res := deleteLeadVariablesResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type uniboxEmail struct {
+	Id               string `json:"id"`
+	TimestampCreated string `json:"timestamp_created"`
+	Eaccount         string `json:"eaccount"`
+	FromAddressEmail string `json:"from_address_email"`
+}
+
+type listUniboxEmailsResponse []uniboxEmail
+
+type sendReplyPayload struct {
+	ReplyToUuid string `json:"reply_to_uuid"`
+	From        string `json:"from"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+}
+
+type sendReplyResponse struct {
+	Status string `json:"status"`
+}
+
+// SendReply answers the lead's most recent email in the campaign, from the
+// account that email was sent to. Replying to that email is what keeps the
+// answer in the same thread, so there must be one to reply to.
+func (c *Client) SendReply(ctx context.Context, campaignId, email, subject, body string) error {
+	data, err := c.get(ctx, "unibox/emails", []query{
+		param("campaign_id", campaignId),
+		param("lead", email),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send reply: %w", err)
+	}
+
+	emails := listUniboxEmailsResponse{}
+	err = json.Unmarshal(data, &emails)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
+	}
+
+	var latest *uniboxEmail
+	var latestTime time.Time
+	for i := range emails {
+		if !strings.EqualFold(emails[i].FromAddressEmail, email) {
+			continue
+		}
+
+		timestamp := parseTimestamp(c.options.logger, "timestamp_created", emails[i].TimestampCreated)
+		if latest == nil || timestamp.After(latestTime) {
+			latest = &emails[i]
+			latestTime = timestamp
+		}
+	}
+
+	if latest == nil {
+		return fmt.Errorf("failed to send reply: no email from %s in campaign %s", email, campaignId)
+	}
+
+	payload := sendReplyPayload{
+		ReplyToUuid: latest.Id,
+		From:        latest.Eaccount,
+		Subject:     subject,
+		Body:        body,
+	}
+
+	data, err = c.post(ctx, "unibox/emails/reply", payload)
+	if err != nil {
+		return fmt.Errorf("failed to send reply: %w", err)
+	}
+
+	res := sendReplyResponse{}
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -944,37 +3166,172 @@ type addEntriesToBlocklistResponse struct {
 	BlocklistId        string `json:"blocklist_id"`
 }
 
-func (c *Client) AddEntriesToBlocklist(entries []string) (entriesAdded int, err error) {
+func (c *Client) AddEntriesToBlocklist(ctx context.Context, entries []string) (entriesAdded int, err error) {
+	res, err := c.addEntriesToBlocklist(ctx, entries)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.EntriesAdded, nil
+}
+
+func (c *Client) addEntriesToBlocklist(ctx context.Context, entries []string) (*addEntriesToBlocklistResponse, error) {
 	payload := addEntriesToBlocklistPayload{
 		Entries: entries,
 	}
 
-	data, err := c.post("blocklist/add/entries", payload)
+	data, err := c.post(ctx, "blocklist/add/entries", payload)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add entries to blocklist: %w", err)
+		return nil, fmt.Errorf("failed to add entries to blocklist: %w", err)
 	}
 
-	res := addEntriesToBlocklistResponse{}
-	err = json.Unmarshal(data, &res)
+	res := &addEntriesToBlocklistResponse{}
+	err = json.Unmarshal(data, res)
 	if err != nil {
-		return 0, ErrMarshalFailed
+		return nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return 0, fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return nil, err
 	}
 
-	return res.EntriesAdded, nil
+	return res, nil
+}
+
+type BlocklistResult struct {
+	EntriesAdded       int
+	AlreadyInBlocklist int
+}
+
+// AddBlocklistFromReader uploads every email or domain read from r, which may
+// hold one entry per line or CSV. Entries are taken from the first column, or
+// from the email or domain column when the first row is a header naming one.
+// Entries that are neither an email nor a domain are logged and skipped. On
+// error, the result counts the chunks uploaded before the failure.
+func (c *Client) AddBlocklistFromReader(ctx context.Context, r io.Reader) (*BlocklistResult, error) {
+	const chunkSize = 500
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	result := &BlocklistResult{}
+	upload := func(entries []string) error {
+		res, err := c.addEntriesToBlocklist(ctx, entries)
+		if err != nil {
+			return err
+		}
+
+		result.EntriesAdded += res.EntriesAdded
+		result.AlreadyInBlocklist += res.AlreadyInBlocklist
+		return nil
+	}
+
+	column := 0
+	first := true
+	chunk := make([]string, 0, chunkSize)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to read blocklist entries: %w", err)
+		}
+
+		if first {
+			first = false
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+
+			header := blocklistColumn(record)
+			if header >= 0 {
+				column = header
+				continue
+			}
+		}
+
+		if column >= len(record) {
+			continue
+		}
+
+		entry := strings.TrimSpace(record[column])
+		if entry == "" {
+			continue
+		}
+		if !validEmail(entry) && !validDomain(entry) {
+			line, _ := reader.FieldPos(column)
+			c.options.logger.Printf("instantly: skipping blocklist entry %q on line %d, not an email or domain", entry, line)
+			continue
+		}
+
+		chunk = append(chunk, entry)
+		if len(chunk) == chunkSize {
+			err = upload(chunk)
+			if err != nil {
+				return result, err
+			}
+
+			chunk = chunk[:0]
+		}
+	}
+
+	if len(chunk) > 0 {
+		err := upload(chunk)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// blocklistColumn returns the index of the email or domain column if record
+// is a header row, and -1 otherwise.
+func blocklistColumn(record []string) int {
+	for i, field := range record {
+		switch strings.ToLower(strings.TrimSpace(field)) {
+		case "email", "domain":
+			return i
+		}
+	}
+
+	return -1
+}
+
+// validDomain reports whether s is a hostname with at least two labels, such
+// as example.com.
+func validDomain(s string) bool {
+	labels := strings.Split(s, ".")
+	if len(s) > 253 || len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
 }
 
 type listAccountsResponse struct {
-	Status   string `json:"status"`
-	Accounts []struct {
-		Email            string   `json:"email"`
-		TimestampCreated string   `json:"timestamp_created"`
-		TimestampUpdated string   `json:"timestamp_updated"`
-		Payload          *Payload `json:"payload"`
-	} `json:"accounts"`
+	Status   string            `json:"status"`
+	Accounts []json.RawMessage `json:"accounts"`
+}
+
+type listAccountsAccount struct {
+	Email            string   `json:"email"`
+	TimestampCreated string   `json:"timestamp_created"`
+	TimestampUpdated string   `json:"timestamp_updated"`
+	Payload          *Payload `json:"payload"`
 }
 
 type Payload struct {
@@ -983,75 +3340,235 @@ type Payload struct {
 		First string `json:"first"`
 	} `json:"name"`
 	Warmup struct {
-		Limit    int `json:"limit"`
-		Advanced struct {
-			WarmCtd        bool `json:"warm_ctd"`
-			OpenRate       int  `json:"open_rate"`
-			WeekdayOnly    bool `json:"weekday_only"`
-			ImportantRate  int  `json:"important_rate"`
-			ReadEmulation  bool `json:"read_emulation"`
-			SpamSaveRate   int  `json:"spam_save_rate"`
-			RandomRangeMin int  `json:"random_range_min"`
-			RandomRangeMax int  `json:"random_range_max"`
-		} `json:"advanced"`
-		Increment int `json:"increment"`
-		ReplyRate int `json:"reply_rate"`
+		Limit     int            `json:"limit"`
+		Advanced  WarmupAdvanced `json:"advanced"`
+		Increment int            `json:"increment"`
+		ReplyRate int            `json:"reply_rate"`
 	} `json:"warmup"`
 	ImapHost   string `json:"imap_host"`
 	ImapPort   int    `json:"imap_port"`
 	SmtpHost   string `json:"smtp_host"`
-	SmtpPort   string `json:"smtp_port"`
+	SmtpPort   int    `json:"smtp_port"`
 	DailyLimit int    `json:"daily_limit"`
 	SendingGap string `json:"sending_gap"`
 }
 
+type WarmupAdvanced struct {
+	WarmCtd        bool `json:"warm_ctd"`
+	OpenRate       int  `json:"open_rate"`
+	WeekdayOnly    bool `json:"weekday_only"`
+	ImportantRate  int  `json:"important_rate"`
+	ReadEmulation  bool `json:"read_emulation"`
+	SpamSaveRate   int  `json:"spam_save_rate"`
+	RandomRangeMin int  `json:"random_range_min"`
+	RandomRangeMax int  `json:"random_range_max"`
+}
+
+// UnmarshalJSON accepts ports sent either as numbers or as strings; the API
+// has been seen to quote smtp_port but not imap_port.
+func (p *Payload) UnmarshalJSON(data []byte) error {
+	type payload Payload
+	aux := struct {
+		*payload
+		ImapPort looseInt `json:"imap_port"`
+		SmtpPort looseInt `json:"smtp_port"`
+	}{payload: (*payload)(p)}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	p.ImapPort = int(aux.ImapPort)
+	p.SmtpPort = int(aux.SmtpPort)
+	return nil
+}
+
 type Account struct {
 	Email            string
 	TimestampCreated time.Time
 	TimestampUpdated time.Time
+	SendingGap       time.Duration
 	Payload          *Payload
 }
 
-func (c *Client) ListAccounts(limit, skip int) ([]Account, error) {
-	data, err := c.get("account/list", []query{
+// ListAccounts returns one page of accounts. With WithSkipMalformed, accounts
+// that fail to decode are left out and the returned error joins their decode
+// errors, so a non-nil error may accompany the accounts that did decode.
+func (c *Client) ListAccounts(ctx context.Context, limit, skip int) ([]Account, error) {
+	accounts, _, err := c.listAccounts(ctx, limit, skip)
+	return accounts, err
+}
+
+// The API stores the sending gap as a string holding whole minutes.
+func parseSendingGap(logger *log.Logger, payload *Payload) time.Duration {
+	if payload == nil || payload.SendingGap == "" {
+		return 0
+	}
+
+	minutes, err := strconv.Atoi(payload.SendingGap)
+	if err != nil {
+		logger.Printf("instantly: unrecognized sending_gap %q, using zero", payload.SendingGap)
+		return 0
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// ListAllAccounts pages through every account. If ctx is done before the last
+// page, the accounts listed so far are returned along with the error.
+func (c *Client) ListAllAccounts(ctx context.Context) ([]Account, error) {
+	accounts, skipped, err := c.listAllAccounts(ctx)
+	if err != nil {
+		return accounts, err
+	}
+
+	return accounts, skipped
+}
+
+// listAllAccounts keeps the errors for malformed accounts skipped under
+// WithSkipMalformed apart from err, so that callers which only need the
+// accounts that decoded are not failed by them.
+func (c *Client) listAllAccounts(ctx context.Context) (accounts []Account, skipped error, err error) {
+	pageSize := c.options.pageSize
+
+	var malformed []error
+	for skip := 0; ; skip += pageSize {
+		err := ctx.Err()
+		if err != nil {
+			return accounts, nil, err
+		}
+
+		page, received, err := c.listAccounts(ctx, pageSize, skip)
+		if err != nil && page == nil && ctx.Err() != nil {
+			return accounts, nil, err
+		}
+		if err != nil && page == nil {
+			return nil, nil, err
+		}
+		if err != nil {
+			malformed = append(malformed, err)
+		}
+
+		accounts = append(accounts, page...)
+		if received < pageSize {
+			return accounts, errors.Join(malformed...), nil
+		}
+	}
+}
+
+// listAccounts also reports how many accounts the API sent, which differs
+// from len(accounts) when malformed ones are skipped.
+func (c *Client) listAccounts(ctx context.Context, limit, skip int) (accounts []Account, received int, err error) {
+	data, err := c.get(ctx, "account/list", []query{
 		param("limit", strconv.Itoa(limit)),
 		param("skip", strconv.Itoa(skip)),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list accounts: %w", err)
+		return nil, 0, fmt.Errorf("failed to list accounts: %w", err)
 	}
 
 	res := listAccountsResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return nil, ErrUnmarshalFailed
+		return nil, 0, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return nil, fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	accounts := make([]Account, len(res.Accounts))
-	for i, account := range res.Accounts {
-		timestampCreated, err := time.Parse(time.RFC3339, account.TimestampCreated)
+	var malformed []error
+	accounts = make([]Account, 0, len(res.Accounts))
+	for i, raw := range res.Accounts {
+		account := listAccountsAccount{}
+		err = json.Unmarshal(raw, &account)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse timestamp created: %w", err)
-		}
+			err = fmt.Errorf("account %d: %w: %w", skip+i, ErrUnmarshalFailed, err)
+			if !c.options.skipMalformed {
+				return nil, 0, err
+			}
 
-		timestampUpdated, err := time.Parse(time.RFC3339, account.TimestampUpdated)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse timestamp updated: %w", err)
+			malformed = append(malformed, err)
+			continue
 		}
 
-		accounts[i] = Account{
+		accounts = append(accounts, Account{
 			Email:            account.Email,
-			TimestampCreated: timestampCreated,
-			TimestampUpdated: timestampUpdated,
+			TimestampCreated: parseTimestamp(c.options.logger, "timestamp_created", account.TimestampCreated),
+			TimestampUpdated: parseTimestamp(c.options.logger, "timestamp_updated", account.TimestampUpdated),
+			SendingGap:       parseSendingGap(c.options.logger, account.Payload),
 			Payload:          account.Payload,
+		})
+	}
+
+	return accounts, len(res.Accounts), errors.Join(malformed...)
+}
+
+// findAccount looks an account up by email. There is no endpoint for a
+// single account, so this pages through every account in the workspace.
+func (c *Client) findAccount(ctx context.Context, email string) (*Account, error) {
+	accounts, _, err := c.listAllAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range accounts {
+		if accounts[i].Email == email {
+			return &accounts[i], nil
 		}
 	}
 
-	return accounts, nil
+	return nil, fmt.Errorf("account not found: %s", email)
+}
+
+// SendingSchedule is an account's own sending constraints, which apply in
+// every campaign it sends for. Accounts have no sending window of their own;
+// when emails go out is set by each campaign's CampaignSchedule.
+type SendingSchedule struct {
+	DailyLimit int
+	SendingGap time.Duration
+}
+
+func (c *Client) GetAccountSendingSchedule(ctx context.Context, email string) (*SendingSchedule, error) {
+	account, err := c.findAccount(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account sending schedule: %w", err)
+	}
+
+	if account.Payload == nil {
+		return nil, fmt.Errorf("failed to get account sending schedule: no settings for account: %s", email)
+	}
+
+	return &SendingSchedule{
+		DailyLimit: account.Payload.DailyLimit,
+		SendingGap: account.SendingGap,
+	}, nil
+}
+
+// WarmupRamp is how an account's warmup volume grows: Increment more emails
+// each day until Limit is reached. The API does not expose a separate
+// starting volume.
+type WarmupRamp struct {
+	Increment int
+	Limit     int
+}
+
+func (c *Client) GetWarmupRamp(ctx context.Context, email string) (*WarmupRamp, error) {
+	account, err := c.findAccount(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warmup ramp: %w", err)
+	}
+
+	if account.Payload == nil {
+		return nil, fmt.Errorf("failed to get warmup ramp: no settings for account: %s", email)
+	}
+
+	return &WarmupRamp{
+		Increment: account.Payload.Warmup.Increment,
+		Limit:     account.Payload.Warmup.Limit,
+	}, nil
 }
 
 type checkAccountVitalsPayload struct {
@@ -1072,12 +3589,37 @@ type AccountVitals struct {
 	Dmarc  bool
 }
 
-func (c *Client) CheckAccountVitals(accounts []string) (successList, failureList []AccountVitals, err error) {
+// CheckAccountVitals checks the accounts in batches, one request per batch,
+// and merges the results.
+func (c *Client) CheckAccountVitals(ctx context.Context, accounts []string) (successList, failureList []AccountVitals, err error) {
+	const batchSize = 50
+
+	successList = make([]AccountVitals, 0, len(accounts))
+	failureList = make([]AccountVitals, 0)
+	for start := 0; start < len(accounts); start += batchSize {
+		end := start + batchSize
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+
+		batchSuccess, batchFailure, err := c.checkAccountVitals(ctx, accounts[start:end])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		successList = append(successList, batchSuccess...)
+		failureList = append(failureList, batchFailure...)
+	}
+
+	return successList, failureList, nil
+}
+
+func (c *Client) checkAccountVitals(ctx context.Context, accounts []string) (successList, failureList []AccountVitals, err error) {
 	payload := checkAccountVitalsPayload{
 		Accounts: accounts,
 	}
 
-	data, err := c.post("account/test/vitals", payload)
+	data, err := c.post(ctx, "account/test/vitals", payload)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to check account vitals: %w", err)
 	}
@@ -1085,11 +3627,12 @@ func (c *Client) CheckAccountVitals(accounts []string) (successList, failureList
 	res := checkAccountVitalsResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return nil, nil, ErrUnmarshalFailed
+		return nil, nil, fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return nil, nil, fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	successList = make([]AccountVitals, len(res.SuccessList))
@@ -1117,6 +3660,26 @@ func (c *Client) CheckAccountVitals(accounts []string) (successList, failureList
 	return successList, failureList, nil
 }
 
+// GetAccountVitals checks a single account. If the account's domain fails the
+// check, its vitals are returned together with ErrAccountVitalsFailed so the
+// failing records can still be inspected.
+func (c *Client) GetAccountVitals(ctx context.Context, email string) (*AccountVitals, error) {
+	successList, failureList, err := c.CheckAccountVitals(ctx, []string{email})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(failureList) > 0 {
+		return &failureList[0], fmt.Errorf("%w: %s", ErrAccountVitalsFailed, failureList[0].Domain)
+	}
+
+	if len(successList) == 0 {
+		return nil, fmt.Errorf("no vitals returned for account: %s", email)
+	}
+
+	return &successList[0], nil
+}
+
 type enableWarmupPayload struct {
 	Email string `json:"email"`
 }
@@ -1125,12 +3688,12 @@ type enableWarmupResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) EnableWarmup(email string) error {
+func (c *Client) EnableWarmup(ctx context.Context, email string) error {
 	payload := enableWarmupPayload{
 		Email: email,
 	}
 
-	data, err := c.post("account/warmup/enable", payload)
+	data, err := c.post(ctx, "account/warmup/enable", payload)
 	if err != nil {
 		return fmt.Errorf("failed to enable warmup: %w", err)
 	}
@@ -1138,11 +3701,12 @@ func (c *Client) EnableWarmup(email string) error {
 	res := enableWarmupResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -1156,12 +3720,12 @@ type pauseWarmupResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) PauseWarmup(email string) error {
+func (c *Client) PauseWarmup(ctx context.Context, email string) error {
 	payload := pauseWarmupPayload{
 		Email: email,
 	}
 
-	data, err := c.post("account/warmup/pause", payload)
+	data, err := c.post(ctx, "account/warmup/pause", payload)
 	if err != nil {
 		return fmt.Errorf("failed to pause warmup: %w", err)
 	}
@@ -1169,11 +3733,12 @@ func (c *Client) PauseWarmup(email string) error {
 	res := pauseWarmupResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -1187,12 +3752,12 @@ type markAccountAsFixedResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) MarkAccountAsFixed(email string) error {
+func (c *Client) MarkAccountAsFixed(ctx context.Context, email string) error {
 	payload := markAccountAsFixedPayload{
 		Email: email,
 	}
 
-	data, err := c.post("account/mark_fixed", payload)
+	data, err := c.post(ctx, "account/mark_fixed", payload)
 	if err != nil {
 		return fmt.Errorf("failed to mark accounts as fixed: %w", err)
 	}
@@ -1200,20 +3765,21 @@ func (c *Client) MarkAccountAsFixed(email string) error {
 	res := markAccountAsFixedResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (c *Client) MarkAllAccountsAsFixed() error {
+func (c *Client) MarkAllAccountsAsFixed(ctx context.Context) error {
 	payload := markAccountAsFixedPayload{}
 
-	data, err := c.post("account/mark_fixed", payload)
+	data, err := c.post(ctx, "account/mark_fixed", payload)
 	if err != nil {
 		return fmt.Errorf("failed to mark accounts as fixed: %w", err)
 	}
@@ -1221,11 +3787,12 @@ func (c *Client) MarkAllAccountsAsFixed() error {
 	res := markAccountAsFixedResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -1239,12 +3806,12 @@ type deleteAccountResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) DeleteAccount(email string) error {
+func (c *Client) DeleteAccount(ctx context.Context, email string) error {
 	payload := deleteAccountPayload{
 		Email: email,
 	}
 
-	data, err := c.post("account/delete", payload)
+	data, err := c.post(ctx, "account/delete", payload)
 	if err != nil {
 		return fmt.Errorf("failed to delete account: %w", err)
 	}
@@ -1252,12 +3819,50 @@ func (c *Client) DeleteAccount(email string) error {
 	res := deleteAccountResponse{}
 	err = json.Unmarshal(data, &res)
 	if err != nil {
-		return ErrUnmarshalFailed
+		return fmt.Errorf("%w: %w", ErrUnmarshalFailed, err)
 	}
 
-	if res.Status != "success" {
-		return fmt.Errorf("return status not successful: %s", res.Status)
+	err = parseStatus(res.Status)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
+
+// DeleteAccounts deletes the accounts a few at a time, carrying on past
+// failures, and returns the emails deleted along with the error for each one
+// that was not. There is no bulk endpoint, so each account is one request.
+func (c *Client) DeleteAccounts(ctx context.Context, emails []string) (deleted []string, errs map[string]error) {
+	// Requests are rate limited anyway; a few in flight keep the limiter
+	// busy without piling up goroutines.
+	const concurrency = 4
+
+	errs = make(map[string]error)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, email := range emails {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(email string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := c.DeleteAccount(ctx, email)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[email] = err
+				return
+			}
+
+			deleted = append(deleted, email)
+		}(email)
+	}
+	wg.Wait()
+
+	return deleted, errs
+}
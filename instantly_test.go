@@ -0,0 +1,932 @@
+package instantly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+// newTestClient returns a client talking to a TLS mock server serving
+// handler. Rate limiting is off so tests are not slowed down by it.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]Option{
+		WithHost(srv.URL),
+		WithHttpClient(*srv.Client()),
+		WithRateLimit(ratelimit.NewUnlimited()),
+		WithLogger(log.New(io.Discard, "", 0)),
+	}, opts...)
+
+	c, err := New("test-key", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+// Run with -race: every piece of state the client mutates while serving
+// requests is touched from many goroutines at once.
+func TestClientConcurrentUse(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+
+		switch r.URL.Path {
+		case "/api/v1/campaign/list":
+			// One 429 sends the client into its cooldown.
+			if n == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			if r.Header.Get("If-None-Match") == `"campaigns"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", `"campaigns"`)
+			w.Write([]byte(`[{"id":"c1","name":"Campaign"}]`))
+		case "/api/v1/lead/add":
+			w.Write([]byte(`{"status":"success","leads_uploaded":1}`))
+		default:
+			http.NotFound(w, r)
+		}
+	},
+		WithCooldown(time.Millisecond),
+		WithCircuitBreaker(1000, time.Millisecond),
+		WithCache(NewMemoryCache()),
+	)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			campaigns, err := c.ListCampaigns(ctx, 10, 0)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if len(campaigns) != 1 || campaigns[0].Id != "c1" {
+				t.Errorf("got campaigns %v, want c1", campaigns)
+			}
+
+			_, err = c.AddLeadsToCampaign(ctx, "c1", []Lead{{Email: "lead@example.com"}})
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if c.LastResponse().StatusCode == 0 {
+				t.Error("LastResponse has no status after a request")
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestMoveLeadsToCampaignKeepsRejectedLeads(t *testing.T) {
+	var deleted atomic.Bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/lead/get":
+			w.Write([]byte(`[{"contact":"lead@example.com","lead_data":{"first_name":"Ada"}}]`))
+		case "/api/v1/lead/add":
+			w.Write([]byte(`{"status":"success","total_sent":1,"leads_uploaded":0,"invalid_email_count":1}`))
+		case "/api/v1/lead/delete":
+			deleted.Store(true)
+			w.Write([]byte(`{"status":"success"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	err := c.MoveLeadsToCampaign(context.Background(), "from", "to", []string{"lead@example.com"})
+	if err == nil {
+		t.Error("got nil error for a lead the destination rejected")
+	}
+	if deleted.Load() {
+		t.Error("lead deleted from the source campaign although it was not added")
+	}
+}
+
+func TestMoveLeadsToCampaignMatchesCase(t *testing.T) {
+	var lookups, deleted []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/lead/get":
+			lookups = append(lookups, r.URL.Query().Get("email"))
+			w.Write([]byte(`[{"contact":"Lead@Example.com"}]`))
+		case "/api/v1/lead/add":
+			w.Write([]byte(`{"status":"success","total_sent":1,"leads_uploaded":1}`))
+		case "/api/v1/lead/delete":
+			payload := deleteLeadsFromCampaignPayload{}
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			if err != nil {
+				t.Error(err)
+			}
+
+			deleted = payload.DeleteList
+			w.Write([]byte(`{"status":"success"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	err := c.MoveLeadsToCampaign(context.Background(), "from", "to", []string{" lead@example.com", "LEAD@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(lookups, []string{"lead@example.com"}) {
+		t.Errorf("got lookups %q, want one for the trimmed email", lookups)
+	}
+	if !reflect.DeepEqual(deleted, []string{"Lead@Example.com"}) {
+		t.Errorf("got deleted %q, want the lead as stored in the source", deleted)
+	}
+}
+
+func TestGetLeadFromCampaignStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		want       int
+		wantStatus LeadStatus
+	}{
+		{"code", `1`, 1, LeadStatusActive},
+		{"quoted code", `"-1"`, -1, LeadStatusBounced},
+		{"sequence status name", `"completed"`, 3, LeadStatusCompleted},
+		{"status without a code", `"interested"`, 0, LeadStatusInterested},
+		{"unknown status", `"Snoozed"`, 0, "Snoozed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				// The shape lead/get returns.
+				w.Write([]byte(`[{
+					"id": "0b6b2ab1-3c5e-4a8f-9a3b-6f1c2d7e8f90",
+					"timestamp_created": "2023-05-02T14:01:22.516Z",
+					"campaign": "c1",
+					"status": ` + tt.status + `,
+					"contact": "lead@example.com",
+					"email_opened": true,
+					"email_replied": false,
+					"lead_data": {"firstName": "Ada"},
+					"campaign_name": "Campaign"
+				}]`))
+			})
+
+			lead, err := c.GetLeadFromCampaign(context.Background(), "c1", "lead@example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if lead.Status != tt.want || lead.LeadStatus != tt.wantStatus {
+				t.Errorf("got status %d %q, want %d %q", lead.Status, lead.LeadStatus, tt.want, tt.wantStatus)
+			}
+			if lead.Contact != "lead@example.com" || !lead.EmailOpened {
+				t.Errorf("lead decoded wrongly: %+v", lead)
+			}
+		})
+	}
+}
+
+// cancelOnSecondPage serves one full page of a single record, then cancels
+// the caller's context while the second page is being fetched.
+func cancelOnSecondPage(cancel context.CancelFunc, firstPage string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skip := r.URL.Query().Get("skip")
+		if skip == "" || skip == "0" {
+			w.Write([]byte(firstPage))
+			return
+		}
+
+		cancel()
+		<-r.Context().Done()
+	}
+}
+
+func TestPagingStopsOnCancel(t *testing.T) {
+	t.Run("campaigns", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		c := newTestClient(t, cancelOnSecondPage(cancel, `[{"id":"c1","name":"Campaign"}]`), WithPageSize(1))
+
+		campaigns, err := c.ListAllCampaigns(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want context.Canceled", err)
+		}
+		if len(campaigns) != 1 {
+			t.Errorf("got %d campaigns, want the 1 from the first page", len(campaigns))
+		}
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		c := newTestClient(t, cancelOnSecondPage(cancel, `{"status":"success","accounts":[{"email":"a@example.com"}]}`), WithPageSize(1))
+
+		accounts, err := c.ListAllAccounts(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want context.Canceled", err)
+		}
+		if len(accounts) != 1 {
+			t.Errorf("got %d accounts, want the 1 from the first page", len(accounts))
+		}
+	})
+
+}
+
+func TestPreviewLeadUpload(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("email") == "old@example.com" {
+			w.Write([]byte(`[{"contact":"Old@example.com"}]`))
+			return
+		}
+
+		w.Write([]byte(`[]`))
+	})
+
+	preview, err := c.PreviewLeadUpload(context.Background(), "c1", []Lead{
+		{Email: "Old@example.com"},
+		{Email: "new@example.com"},
+		{Email: " NEW@example.com"},
+		{Email: "not an email"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &UploadPreview{
+		New:       []string{"new@example.com"},
+		Existing:  []string{"Old@example.com"},
+		Invalid:   []string{"not an email"},
+		Duplicate: []string{" NEW@example.com"},
+	}
+	if !reflect.DeepEqual(preview, want) {
+		t.Errorf("got %+v, want %+v", preview, want)
+	}
+}
+
+func TestIsAuthenticated(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    bool
+		wantErr bool
+	}{
+		{"accepted", http.StatusOK, `{"workspace_name":"Acme"}`, true, false},
+		{"rejected", http.StatusUnauthorized, `{"error":"Invalid API key"}`, false, false},
+		{"forbidden", http.StatusForbidden, `{"error":"Forbidden"}`, false, false},
+		{"outage", http.StatusBadGateway, `<html>502 Bad Gateway</html>`, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}, WithMaxRetries(0))
+
+			ok, err := c.IsAuthenticated(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+			if ok != tt.want {
+				t.Errorf("got %t, want %t", ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAuthenticatedCached(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"workspace"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"workspace"`)
+		w.Write([]byte(`{"workspace_name":"Acme"}`))
+	}, WithCache(NewMemoryCache()))
+
+	for i := 0; i < 2; i++ {
+		ok, err := c.IsAuthenticated(context.Background())
+		if err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+		if !ok {
+			t.Errorf("request %d: got false, want true", i+1)
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("got %d requests, want 2", n)
+	}
+}
+
+func TestGetCampaignByIdNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"campaign_id":"` + r.URL.Query().Get("campaign_id") + `"}`))
+	})
+
+	campaign, err := c.GetCampaignById(context.Background(), "missing")
+	if !errors.Is(err, ErrCampaignNotFound) {
+		t.Errorf("got campaign %v and error %v, want ErrCampaignNotFound", campaign, err)
+	}
+}
+
+func TestPauseCampaignUntil(t *testing.T) {
+	var launched atomic.Bool
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/campaign/launch" {
+			launched.Store(true)
+		}
+		w.Write([]byte(`{"status":"success"}`))
+	}
+
+	t.Run("resumes", func(t *testing.T) {
+		launched.Store(false)
+		c := newTestClient(t, handler)
+
+		ctx, cancelPause := context.WithTimeout(context.Background(), time.Second)
+		resumed, _, err := c.PauseCampaignUntil(ctx, "c1", time.Now().Add(50*time.Millisecond))
+		cancelPause()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The resume outlives the context of the pause request.
+		err = <-resumed
+		if err != nil {
+			t.Errorf("got resume error %v", err)
+		}
+		if !launched.Load() {
+			t.Error("campaign was not launched again")
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		launched.Store(false)
+		c := newTestClient(t, handler)
+
+		resumed, cancel, err := c.PauseCampaignUntil(context.Background(), "c1", time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cancel()
+		err = <-resumed
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got resume error %v, want context.Canceled", err)
+		}
+		if launched.Load() {
+			t.Error("campaign launched after the resume was cancelled")
+		}
+	})
+}
+
+func TestSetCampaignSchedulePayload(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	schedule, err := NewWeekdaySchedule("Office hours", []time.Weekday{time.Monday, time.Friday}, "09:00", "17:00", newYork)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	internal := &internalSetCampaignSchedulePayload{
+		CampaignId: "c1",
+		StartDate:  time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:    &end,
+		Schedules:  []CampaignSchedule{schedule},
+	}
+
+	payload, err := internal.convert()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"campaign_id":"c1","start_date":"2026-11-02","end_date":"2026-12-31","schedules":[` +
+		`{"name":"Office hours","days":{"1":true,"5":true},"timezone":"America/New_York","timing":{"from":"09:00","to":"17:00"}}]}`
+	if string(got) != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestScheduleOverlap(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	jst := time.FixedZone("JST", 9*60*60)
+
+	schedule := func(name string, day time.Weekday, from, to string, tz *time.Location) CampaignSchedule {
+		s, err := NewWeekdaySchedule(name, []time.Weekday{day}, from, to, tz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		schedules []CampaignSchedule
+		overlap   bool
+	}{
+		{
+			"same timezone, overlapping hours",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "09:00", "17:00", time.UTC),
+				schedule("b", time.Monday, "16:00", "18:00", time.UTC),
+			},
+			true,
+		},
+		{
+			"same timezone, back to back",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "09:00", "12:00", time.UTC),
+				schedule("b", time.Monday, "12:00", "17:00", time.UTC),
+			},
+			false,
+		},
+		{
+			"same timezone, different days",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "09:00", "17:00", time.UTC),
+				schedule("b", time.Tuesday, "09:00", "17:00", time.UTC),
+			},
+			false,
+		},
+		{
+			// 09:00-12:00 EST is 14:00-17:00 UTC.
+			"different timezones, overlapping instants",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "09:00", "12:00", est),
+				schedule("b", time.Monday, "15:00", "16:00", time.UTC),
+			},
+			true,
+		},
+		{
+			// 09:00-12:00 JST is 00:00-03:00 UTC.
+			"different timezones, same local hours",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "09:00", "12:00", jst),
+				schedule("b", time.Monday, "09:00", "12:00", time.UTC),
+			},
+			false,
+		},
+		{
+			// Monday 01:00-03:00 JST is Sunday 16:00-18:00 UTC.
+			"overlap across the end of the week",
+			[]CampaignSchedule{
+				schedule("a", time.Monday, "01:00", "03:00", jst),
+				schedule("b", time.Sunday, "17:00", "19:00", time.UTC),
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &internalSetCampaignSchedulePayload{
+				CampaignId: "c1",
+				// A Monday.
+				StartDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+				Schedules: tt.schedules,
+			}
+
+			err := p.validate()
+			if got := errors.Is(err, ErrOverlappingSchedules); got != tt.overlap {
+				t.Errorf("got error %v, want overlap: %t", err, tt.overlap)
+			}
+		})
+	}
+}
+
+func TestAddLeadsToCampaignCounts(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"numbers", `{"status":"success","total_sent":5,"leads_uploaded":2,"already_in_campaign":1,"invalid_email_count":1,"duplicate_email_count":1,"remaining_in_plan":995}`},
+		{"strings", `{"status":"success","total_sent":"5","leads_uploaded":"2","already_in_campaign":"1","invalid_email_count":"1","duplicate_email_count":"1","remaining_in_plan":"995"}`},
+	}
+
+	want := LeadUploadResult{
+		TotalSent:           5,
+		LeadsUploaded:       2,
+		AlreadyInCampaign:   1,
+		InvalidEmailCount:   1,
+		DuplicateEmailCount: 1,
+		RemainingInPlan:     995,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			})
+
+			got, err := c.AddLeadsToCampaign(context.Background(), "c1", []Lead{{Email: "lead@example.com"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != want {
+				t.Errorf("got %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestListAccountsQuotedPort(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","accounts":[{
+			"email": "sender@example.com",
+			"payload": {"imap_host": "imap.example.com", "imap_port": 993, "smtp_host": "smtp.example.com", "smtp_port": "587"}
+		}]}`))
+	})
+
+	accounts, err := c.ListAccounts(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].Payload == nil {
+		t.Fatalf("got accounts %+v, want one with a payload", accounts)
+	}
+
+	payload := accounts[0].Payload
+	if payload.SmtpPort != 587 || payload.ImapPort != 993 {
+		t.Errorf("got smtp port %d and imap port %d, want 587 and 993", payload.SmtpPort, payload.ImapPort)
+	}
+}
+
+func TestSkippedAccountsDoNotFailCallers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authenticate":
+			w.Write([]byte(`{"workspace_name":"Acme"}`))
+		case "/api/v1/account/list":
+			w.Write([]byte(`{"status":"success","accounts":[
+				{"email": "sender@example.com", "payload": {"warmup": {"limit": 40, "increment": 2}}},
+				{"email": 42}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}, WithSkipMalformed())
+
+	ctx := context.Background()
+
+	accounts, err := c.ListAllAccounts(ctx)
+	if !errors.Is(err, ErrUnmarshalFailed) || len(accounts) != 1 {
+		t.Fatalf("got %d accounts and error %v, want 1 and ErrUnmarshalFailed", len(accounts), err)
+	}
+
+	workspace, err := c.GetWorkspaceInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if workspace.AccountCount != 1 {
+		t.Errorf("got %d accounts, want 1", workspace.AccountCount)
+	}
+
+	ramp, err := c.GetWarmupRamp(ctx, "sender@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *ramp != (WarmupRamp{Increment: 2, Limit: 40}) {
+		t.Errorf("got ramp %+v, want increment 2 and limit 40", *ramp)
+	}
+}
+
+func TestDeleteLeadVariablesRequest(t *testing.T) {
+	var path string
+	var body map[string]any
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"status":"success"}`))
+	})
+
+	err := c.DeleteLeadVariables(context.Background(), "c1", "lead@example.com", []string{"plan", "seats"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/api/v1/lead/data/delete" {
+		t.Errorf("got path %s, want /api/v1/lead/data/delete", path)
+	}
+
+	want := map[string]any{
+		"api_key":     "test-key",
+		"campaign_id": "c1",
+		"email":       "lead@example.com",
+		"variables":   []any{"plan", "seats"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("got body %v, want %v", body, want)
+	}
+}
+
+func TestUpdateLeadVariableLargeInteger(t *testing.T) {
+	var body struct {
+		Variables map[string]json.Number `json:"variables"`
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		decoder.Decode(&body)
+		w.Write([]byte(`{"status":"success"}`))
+	})
+
+	// 19 digits, beyond the 53 bits a float64 holds exactly.
+	const id int64 = 1234567890123456789
+	err := c.UpdateLeadVariable(context.Background(), "c1", "lead@example.com", map[string]interface{}{"crm_id": id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := body.Variables["crm_id"]; got != "1234567890123456789" {
+		t.Errorf("got crm_id %s, want 1234567890123456789", got)
+	}
+}
+
+func TestWithHost(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"api.instantly.ai", "api.instantly.ai"},
+		{"https://api.instantly.ai", "api.instantly.ai"},
+		{"http://api.instantly.ai", "api.instantly.ai"},
+		{"HTTPS://api.instantly.ai", "api.instantly.ai"},
+		{"api.instantly.ai/", "api.instantly.ai"},
+		{"https://api.instantly.ai/", "api.instantly.ai"},
+		{"localhost:8443", "localhost:8443"},
+	}
+
+	for _, tt := range tests {
+		o := &options{}
+		err := WithHost(tt.in)(o)
+		if err != nil {
+			t.Errorf("WithHost(%q): %v", tt.in, err)
+			continue
+		}
+		if o.host != tt.want {
+			t.Errorf("WithHost(%q) set host %q, want %q", tt.in, o.host, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "https://", "/"} {
+		err := WithHost(in)(&options{})
+		if err == nil {
+			t.Errorf("WithHost(%q): got nil error", in)
+		}
+	}
+}
+
+func TestGetCampaignCountUsesUTCDays(t *testing.T) {
+	var query map[string][]string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"campaign_id":"c1","total_emails_sent":10}`))
+	})
+
+	// 22:30 on the 15th in New York winter time is 03:30 on the 16th in UTC.
+	est := time.FixedZone("EST", -5*60*60)
+	start := time.Date(2026, 1, 15, 22, 30, 0, 0, est)
+	end := time.Date(2026, 1, 16, 23, 59, 0, 0, est)
+
+	dates, err := NewDateRange(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetCampaignCount(context.Background(), "c1", dates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := query["start_date"]; len(got) != 1 || got[0] != "01-16-2026" {
+		t.Errorf("got start_date %v, want 01-16-2026", got)
+	}
+	if got := query["end_date"]; len(got) != 1 || got[0] != "01-17-2026" {
+		t.Errorf("got end_date %v, want 01-17-2026", got)
+	}
+}
+
+func TestLeadJSON(t *testing.T) {
+	lead := Lead{
+		Email:           "lead@example.com",
+		FirstName:       "Ada",
+		CustomVariables: map[string]string{"plan": "pro"},
+	}
+
+	got, err := json.Marshal(lead)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// lead/add takes custom variables nested, not flattened into the lead.
+	want := `{"email":"lead@example.com","first_name":"Ada","custom_variables":{"plan":"pro"}}`
+	if string(got) != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestGetCampaignProgress(t *testing.T) {
+	var path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"campaign_id":"c1","total_leads":200,"completed":50}`))
+	})
+
+	progress, err := c.GetCampaignProgress(context.Background(), "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/api/v1/analytics/campaign/summary" {
+		t.Errorf("got path %s, want /api/v1/analytics/campaign/summary", path)
+	}
+	if progress != 0.25 {
+		t.Errorf("got progress %v, want 0.25", progress)
+	}
+}
+
+func TestAddBlocklistFromReader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "lines",
+			input: "spam@example.com\nexample.org\nnot an entry\n",
+			want:  []string{"spam@example.com", "example.org"},
+		},
+		{
+			name:  "header",
+			input: "name,email,company\nJane,jane@example.com,example.com\nJoe,joe@example.org,Example Inc.\n",
+			want:  []string{"jane@example.com", "joe@example.org"},
+		},
+		{
+			name:  "first column",
+			input: "example.com,Example Inc.,example.net\nspam@example.org,,\n",
+			want:  []string{"example.com", "spam@example.org"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var entries []string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				payload := addEntriesToBlocklistPayload{}
+				err := json.NewDecoder(r.Body).Decode(&payload)
+				if err != nil {
+					t.Error(err)
+				}
+
+				entries = append(entries, payload.Entries...)
+				w.Write([]byte(`{"status":"success","entries_added":1}`))
+			})
+
+			_, err := c.AddBlocklistFromReader(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(entries, tt.want) {
+				t.Errorf("got entries %q, want %q", entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorsRedactApiKey(t *testing.T) {
+	t.Run("request creation", func(t *testing.T) {
+		// The control character makes the URL fail to parse.
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {}, WithPathPrefix("proxy\x7f"))
+
+		_, err := c.ListCampaigns(context.Background(), 10, 0)
+		if !errors.Is(err, ErrRequestCreationFailed) {
+			t.Fatalf("got error %v, want ErrRequestCreationFailed", err)
+		}
+		if strings.Contains(err.Error(), "test-key") {
+			t.Errorf("error %q contains the API key", err)
+		}
+	})
+
+	t.Run("transport", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.NotFoundHandler())
+		srv.Close()
+
+		c, err := New("test-key", WithHost(srv.URL), WithRateLimit(ratelimit.NewUnlimited()), WithMaxRetries(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = c.ListCampaigns(context.Background(), 10, 0)
+		if !errors.Is(err, ErrRequestExecutionFailed) {
+			t.Fatalf("got error %v, want ErrRequestExecutionFailed", err)
+		}
+		if strings.Contains(err.Error(), "test-key") {
+			t.Errorf("error %q contains the API key", err)
+		}
+	})
+}
+
+func TestDefaultParamsAreEscaped(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`[]`))
+	}, WithDefaultParams(map[string]string{
+		"tag&api_key=other": "a b",
+		"limit":             "99",
+	}))
+
+	_, err := c.ListCampaigns(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{
+		"api_key":           {"test-key"},
+		"limit":             {"10"},
+		"skip":              {"0"},
+		"tag&api_key=other": {"a b"},
+	}
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("got query %v, want %v", query, want)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	withHeader := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": {value}}}
+	}
+
+	tests := []struct {
+		name    string
+		res     *http.Response
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"first attempt", nil, 0, time.Second, time.Second},
+		{"backoff", nil, 4, 16 * time.Second, 16 * time.Second},
+		{"capped", nil, 5, maxBackoff, maxBackoff},
+		{"past the shift width", nil, 70, maxBackoff, maxBackoff},
+		{"seconds", withHeader("3"), 0, 3 * time.Second, 3 * time.Second},
+		{"date", withHeader(time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)), 0, 8 * time.Second, 10 * time.Second},
+		{"past date", withHeader(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)), 1, 2 * time.Second, 2 * time.Second},
+		{"garbage", withHeader("soon"), 1, 2 * time.Second, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retryAfter(tt.res, tt.attempt)
+			if got < tt.min || got > tt.max {
+				t.Errorf("got %s, want between %s and %s", got, tt.min, tt.max)
+			}
+		})
+	}
+}